@@ -1,18 +1,25 @@
 package main
 
 import (
+	"context"
 	"log"
 	"path/filepath"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
+	"github.com/jurisconnect/backend/internal/calendar"
 	"github.com/jurisconnect/backend/internal/config"
 	"github.com/jurisconnect/backend/internal/database"
 	"github.com/jurisconnect/backend/internal/handlers"
+	"github.com/jurisconnect/backend/internal/mail"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/pix"
 	"github.com/jurisconnect/backend/internal/repositories"
 	"github.com/jurisconnect/backend/internal/routes"
+	"github.com/jurisconnect/backend/internal/security"
 	"github.com/jurisconnect/backend/internal/services"
+	"github.com/jurisconnect/backend/internal/storage"
 )
 
 func main() {
@@ -49,18 +56,126 @@ func main() {
 	}
 	log.Println("Conexão com MongoDB estabelecida com sucesso!")
 
-	// Inicializar repositório
+	// Inicializar repositórios
 	userRepo := repositories.NewUserRepository(db)
+	refreshTokenRepo := repositories.NewRefreshTokenRepository(db)
+	roleRepo := repositories.NewRoleRepository(db)
+	caseRepo := repositories.NewCaseRepository(db)
+	caseStatusHistoryRepo := repositories.NewCaseStatusHistoryRepository(db)
+	documentRepo := repositories.NewDocumentRepository(db)
+	documentVersionRepo := repositories.NewDocumentVersionRepository(db)
+	clientRepo := repositories.NewClientRepository(db)
+	conflictCheckRepo := repositories.NewConflictCheckRepository(db)
+	holidayRepo := repositories.NewHolidayRepository(db)
+	deadlineRepo := repositories.NewDeadlineRepository(db)
+	eventRepo := repositories.NewEventRepository(db)
+	calendarFeedRepo := repositories.NewCalendarFeedRepository(db)
+	taskRepo := repositories.NewTaskRepository(db)
+	timeEntryRepo := repositories.NewTimeEntryRepository(db)
+	feeAgreementRepo := repositories.NewFeeAgreementRepository(db)
+	invoiceRepo := repositories.NewInvoiceRepository(db)
+	expenseRepo := repositories.NewExpenseRepository(db)
+	userReferenceRepo := repositories.NewUserReferenceRepository(db)
+	recordReferenceRepo := repositories.NewRecordReferenceRepository(db)
+	loginThrottleRepo := repositories.NewLoginThrottleRepository(db)
+	passwordResetTokenRepo := repositories.NewPasswordResetTokenRepository(db)
 
-	// Inicializar serviço
-	userService := services.NewUserService(userRepo)
+	// Inicializar armazenamento de arquivos
+	fileStorage, err := storage.New(cfg)
+	if err != nil {
+		log.Fatalf("Erro ao inicializar armazenamento de arquivos: %v", err)
+	}
+	log.Printf("Armazenamento de arquivos: %s", cfg.Storage.Driver)
+
+	// Inicializar envio de e-mails
+	mailer, err := mail.New(cfg)
+	if err != nil {
+		log.Fatalf("Erro ao inicializar envio de e-mails: %v", err)
+	}
+	log.Printf("Envio de e-mails: %s", cfg.Mail.Driver)
+
+	// Inicializar calendário forense
+	courtCalendar := calendar.New(holidayRepo)
+
+	// Inicializar gerenciador de tokens
+	tokenManager := security.NewTokenManager(cfg.JWT.Secret, cfg.JWT.Expiry)
+
+	// Inicializar serviços
+	userService := services.NewUserService(userRepo, roleRepo)
+	roleService := services.NewRoleService(roleRepo, userRepo)
+	conflictService := services.NewConflictService(clientRepo, caseRepo, conflictCheckRepo)
+	caseService := services.NewCaseService(caseRepo, caseStatusHistoryRepo, userRepo, clientRepo, recordReferenceRepo, conflictService)
+	documentService := services.NewDocumentService(documentRepo, documentVersionRepo, caseRepo, fileStorage)
+	clientService := services.NewClientService(clientRepo, recordReferenceRepo, conflictService)
+	deadlineService := services.NewDeadlineService(deadlineRepo, caseRepo, userRepo, courtCalendar)
+	holidayService := services.NewHolidayService(holidayRepo, courtCalendar)
+	eventService := services.NewEventService(eventRepo, caseRepo, userRepo)
+	calendarFeedService := services.NewCalendarFeedService(calendarFeedRepo, deadlineRepo, eventRepo, caseRepo, userRepo)
+	taskService := services.NewTaskService(taskRepo, caseRepo, userRepo)
+	timeEntryService := services.NewTimeEntryService(timeEntryRepo, caseRepo, clientRepo, userRepo, feeAgreementRepo)
+	feeAgreementService := services.NewFeeAgreementService(feeAgreementRepo, clientRepo, caseRepo)
+	invoiceService := services.NewInvoiceService(invoiceRepo, feeAgreementRepo, timeEntryRepo, expenseRepo, clientRepo, caseRepo)
+	pixMerchant := pix.Merchant{Key: cfg.Pix.Key, Name: cfg.Pix.MerchantName, City: cfg.Pix.MerchantCity}
+	if pixMerchant.Key != "" {
+		if err := pix.ValidateKey(pixMerchant.Key); err != nil {
+			log.Printf("Aviso: PIX_KEY: %v", err)
+		}
+	}
+	pixService := services.NewPixService(invoiceRepo, invoiceService, pixMerchant)
+	expenseService := services.NewExpenseService(expenseRepo, caseRepo, userRepo, documentService)
+	authService := services.NewAuthService(userRepo, refreshTokenRepo, tokenManager, cfg.JWT.RefreshExpiry)
+	userLifecycleService := services.NewUserLifecycleService(userRepo, userReferenceRepo, caseRepo, taskRepo, deadlineRepo, calendarFeedRepo, authService)
+	loginThrottleService := services.NewLoginThrottleService(loginThrottleRepo, userRepo, mailer, cfg.Login)
+	passwordService := services.NewPasswordService(userRepo, passwordResetTokenRepo, authService, loginThrottleService, mailer, cfg.Reset)
+	trashService := services.NewTrashService(userRepo, caseRepo, clientRepo, documentRepo, userReferenceRepo, recordReferenceRepo, documentService, cfg.Trash.Retention)
+
+	// Garantir roles predefinidas
+	if err := roleService.SeedDefaults(); err != nil {
+		log.Fatalf("Erro ao criar roles padrão: %v", err)
+	}
 
-	// Inicializar handler
-	userHandler := handlers.NewUserHandler(userService)
+	// Inicializar handlers
+	userHandler := handlers.NewUserHandler(userService, authService, userLifecycleService, loginThrottleService)
+	authHandler := handlers.NewAuthHandler(authService)
+	roleHandler := handlers.NewRoleHandler(roleService)
+	caseHandler := handlers.NewCaseHandler(caseService)
+	documentHandler := handlers.NewDocumentHandler(documentService, cfg.Storage.MaxUploadSize)
+	clientHandler := handlers.NewClientHandler(clientService)
+	conflictHandler := handlers.NewConflictHandler(conflictService)
+	deadlineHandler := handlers.NewDeadlineHandler(deadlineService)
+	holidayHandler := handlers.NewHolidayHandler(holidayService)
+	eventHandler := handlers.NewEventHandler(eventService)
+	calendarFeedHandler := handlers.NewCalendarFeedHandler(calendarFeedService)
+	taskHandler := handlers.NewTaskHandler(taskService)
+	timeEntryHandler := handlers.NewTimeEntryHandler(timeEntryService)
+	feeAgreementHandler := handlers.NewFeeAgreementHandler(feeAgreementService)
+	invoiceHandler := handlers.NewInvoiceHandler(invoiceService)
+	pixHandler := handlers.NewPixHandler(pixService)
+	expenseHandler := handlers.NewExpenseHandler(expenseService, cfg.Storage.MaxUploadSize)
+	trashHandler := handlers.NewTrashHandler(trashService)
+	passwordHandler := handlers.NewPasswordHandler(passwordService, authService)
+
+	// Remover definitivamente os registros com retenção expirada na lixeira
+	if cfg.Trash.Retention > 0 && cfg.Trash.PurgeInterval > 0 {
+		go trashService.RunPurge(context.Background(), cfg.Trash.PurgeInterval)
+		log.Printf("Lixeira: retenção de %s, limpeza a cada %s", cfg.Trash.Retention, cfg.Trash.PurgeInterval)
+	}
+
+	// Passar a vencidas as faturas emitidas e não pagas no vencimento
+	if cfg.Invoice.OverdueInterval > 0 {
+		go invoiceService.RunOverdue(context.Background(), cfg.Invoice.OverdueInterval)
+	}
 
 	// Configurar router
 	router := gin.Default()
 
+	// Sem proxies confiáveis, c.ClientIP() usa o IP da conexão e ignora o
+	// X-Forwarded-For, que o cliente poderia forjar para escapar do limite de login por IP
+	if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		log.Fatalf("Erro em TRUSTED_PROXIES: %v", err)
+	}
+	router.TrustedPlatform = cfg.Server.TrustedPlatform
+
 	// Configurar CORS
 	router.Use(cors.New(cors.Config{
 		AllowAllOrigins:  true,
@@ -72,7 +187,29 @@ func main() {
 	}))
 
 	// Configurar rotas
-	routes.SetupRoutes(router, userHandler)
+	authMiddleware := middleware.AuthMiddleware(tokenManager, userService)
+	authorizer := middleware.NewAuthorizer(userService)
+	routes.SetupRoutes(router, routes.Handlers{
+		User:     userHandler,
+		Auth:     authHandler,
+		Role:     roleHandler,
+		Case:     caseHandler,
+		Document: documentHandler,
+		Client:   clientHandler,
+		Conflict: conflictHandler,
+		Deadline: deadlineHandler,
+		Holiday:  holidayHandler,
+		Event:    eventHandler,
+		Feed:     calendarFeedHandler,
+		Task:     taskHandler,
+		Time:     timeEntryHandler,
+		Fee:      feeAgreementHandler,
+		Invoice:  invoiceHandler,
+		Pix:      pixHandler,
+		Expense:  expenseHandler,
+		Trash:    trashHandler,
+		Password: passwordHandler,
+	}, authMiddleware, authorizer)
 
 	// Iniciar servidor
 	port := cfg.Server.Port
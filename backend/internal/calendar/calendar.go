@@ -0,0 +1,190 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/domain"
+)
+
+// DateLayout é o formato das datas trocadas com a API
+const DateLayout = "2006-01-02"
+
+// Motivos de dia não útil
+const (
+	ReasonSaturday = "sábado"
+	ReasonSunday   = "domingo"
+	ReasonRecess   = "recesso forense (CPC, art. 220)"
+)
+
+// maxSearchDays limita a busca por dias úteis, evitando laços infinitos com
+// calendários mal configurados
+const maxSearchDays = 366
+
+type fixedHoliday struct {
+	month time.Month
+	day   int
+	name  string
+}
+
+// nationalHolidays são os feriados nacionais de data fixa (Leis 662/1949,
+// 6.802/1980 e 14.759/2023)
+var nationalHolidays = []fixedHoliday{
+	{time.January, 1, "Confraternização Universal"},
+	{time.April, 21, "Tiradentes"},
+	{time.May, 1, "Dia do Trabalho"},
+	{time.September, 7, "Independência do Brasil"},
+	{time.October, 12, "Nossa Senhora Aparecida"},
+	{time.November, 2, "Finados"},
+	{time.November, 15, "Proclamação da República"},
+	{time.November, 20, "Dia Nacional de Zumbi e da Consciência Negra"},
+	{time.December, 25, "Natal"},
+}
+
+// Date descarta o horário, representando o dia à meia-noite UTC
+func Date(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// ParseDate interpreta uma data no formato AAAA-MM-DD
+func ParseDate(value string) (time.Time, error) {
+	date, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("data inválida %q, use o formato AAAA-MM-DD", value)
+	}
+	return date, nil
+}
+
+// IsRecess informa se a data está no recesso de 20 de dezembro a 20 de
+// janeiro, período em que os prazos processuais ficam suspensos
+func IsRecess(date time.Time) bool {
+	month, day := date.Month(), date.Day()
+	return (month == time.December && day >= 20) || (month == time.January && day <= 20)
+}
+
+// Calendar responde se um dia é útil para um juízo, combinando fins de
+// semana, recesso, feriados nacionais (fixos e móveis) e os feriados e
+// suspensões cadastrados
+type Calendar struct {
+	holidays domain.HolidayRepository
+}
+
+func New(holidays domain.HolidayRepository) *Calendar {
+	return &Calendar{holidays: holidays}
+}
+
+// For cria uma consulta para a UF e o órgão informados. A consulta guarda os
+// feriados já carregados e não deve ser compartilhada entre requisições.
+func (c *Calendar) For(state, court string) *Lookup {
+	return &Lookup{calendar: c, state: state, court: court, years: make(map[int]map[string]string)}
+}
+
+// Lookup consulta dias úteis de um juízo específico
+type Lookup struct {
+	calendar *Calendar
+	state    string
+	court    string
+	years    map[int]map[string]string
+}
+
+// Reason retorna o motivo de a data não ser dia útil, ou "" se for útil
+func (l *Lookup) Reason(date time.Time) (string, error) {
+	date = Date(date)
+	switch date.Weekday() {
+	case time.Saturday:
+		return ReasonSaturday, nil
+	case time.Sunday:
+		return ReasonSunday, nil
+	}
+
+	holidays, err := l.year(date.Year())
+	if err != nil {
+		return "", err
+	}
+	if name, ok := holidays[date.Format(DateLayout)]; ok {
+		return name, nil
+	}
+
+	if IsRecess(date) {
+		return ReasonRecess, nil
+	}
+	return "", nil
+}
+
+// IsWorkingDay informa se a data é dia útil
+func (l *Lookup) IsWorkingDay(date time.Time) (bool, error) {
+	reason, err := l.Reason(date)
+	return reason == "", err
+}
+
+// NextWorkingDay retorna o primeiro dia útil estritamente posterior à data,
+// junto com os dias não úteis saltados
+func (l *Lookup) NextWorkingDay(date time.Time) (time.Time, []domain.NonWorkingDay, error) {
+	skipped := []domain.NonWorkingDay{}
+	day := Date(date)
+	for i := 0; i < maxSearchDays; i++ {
+		day = day.AddDate(0, 0, 1)
+		reason, err := l.Reason(day)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		if reason == "" {
+			return day, skipped, nil
+		}
+		skipped = append(skipped, domain.NonWorkingDay{Date: day, Reason: reason})
+	}
+	return time.Time{}, nil, fmt.Errorf("nenhum dia útil encontrado em %d dias após %s", maxSearchDays, date.Format(DateLayout))
+}
+
+// year carrega os feriados do ano aplicáveis ao juízo, indexados por data
+func (l *Lookup) year(year int) (map[string]string, error) {
+	if holidays, ok := l.years[year]; ok {
+		return holidays, nil
+	}
+
+	holidays := moveableHolidays(year)
+	for _, holiday := range nationalHolidays {
+		holidays[time.Date(year, holiday.month, holiday.day, 0, 0, 0, 0, time.UTC).Format(DateLayout)] = holiday.name
+	}
+
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	stored, err := l.calendar.holidays.FindBetween(from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, holiday := range stored {
+		if !holiday.AppliesTo(l.state, l.court) {
+			continue
+		}
+		start, end := Date(holiday.Date), Date(holiday.EndDate)
+		if end.Before(start) {
+			end = start
+		}
+		if !holiday.Recurring {
+			addRange(holidays, start, end, from, to, holiday.Name)
+			continue
+		}
+		// Períodos recorrentes podem começar no ano anterior (ex.: 30/12 a 02/01)
+		for _, shift := range []int{year - 1 - start.Year(), year - start.Year()} {
+			addRange(holidays, start.AddDate(shift, 0, 0), end.AddDate(shift, 0, 0), from, to, holiday.Name)
+		}
+	}
+
+	l.years[year] = holidays
+	return holidays, nil
+}
+
+// addRange marca os dias de start a end que caem entre from e to
+func addRange(holidays map[string]string, start, end, from, to time.Time, name string) {
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		holidays[day.Format(DateLayout)] = name
+	}
+}
@@ -0,0 +1,116 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/domain"
+)
+
+// holidayStub devolve sempre os mesmos feriados cadastrados
+type holidayStub struct {
+	domain.HolidayRepository
+	holidays []*domain.Holiday
+}
+
+func (s holidayStub) FindBetween(from, to time.Time) ([]*domain.Holiday, error) {
+	return s.holidays, nil
+}
+
+func date(value string) time.Time {
+	d, err := time.Parse(DateLayout, value)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestEaster(t *testing.T) {
+	tests := map[int]string{
+		1818: "1818-03-22",
+		1943: "1943-04-25",
+		2000: "2000-04-23",
+		2019: "2019-04-21",
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+		2026: "2026-04-05",
+		2038: "2038-04-25",
+	}
+
+	for year, want := range tests {
+		if got := Easter(year).Format(DateLayout); got != want {
+			t.Errorf("Easter(%d) = %s, esperado %s", year, got, want)
+		}
+	}
+}
+
+func TestLookupReason(t *testing.T) {
+	holidays := holidayStub{holidays: []*domain.Holiday{{
+		Name:      "Revolução Constitucionalista",
+		Date:      date("1932-07-09"),
+		Scope:     domain.HolidayScopeState,
+		State:     "SP",
+		Recurring: true,
+	}}}
+	sp := New(holidays).For("SP", "")
+	rj := New(holidays).For("RJ", "")
+
+	tests := []struct {
+		lookup *Lookup
+		date   string
+		want   string
+	}{
+		{sp, "2025-03-03", "Carnaval"},
+		{sp, "2025-03-04", "Carnaval"},
+		{sp, "2025-04-18", "Sexta-feira Santa"},
+		{sp, "2025-06-19", "Corpus Christi"},
+		{sp, "2026-02-16", "Carnaval"},
+		{sp, "2026-06-04", "Corpus Christi"},
+		{sp, "2025-11-20", "Dia Nacional de Zumbi e da Consciência Negra"},
+		{sp, "2025-12-22", ReasonRecess},
+		{sp, "2026-01-20", ReasonRecess},
+		{sp, "2025-12-25", "Natal"},
+		{sp, "2026-07-09", "Revolução Constitucionalista"},
+		{rj, "2026-07-09", ""},
+		{sp, "2026-07-11", ReasonSaturday},
+		{sp, "2026-07-12", ReasonSunday},
+		{sp, "2026-01-21", ""},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.lookup.Reason(date(tt.date))
+		if err != nil {
+			t.Fatalf("Reason(%s): %v", tt.date, err)
+		}
+		if got != tt.want {
+			t.Errorf("Reason(%s) para %s = %q, esperado %q", tt.date, tt.lookup.state, got, tt.want)
+		}
+	}
+}
+
+func TestNextWorkingDay(t *testing.T) {
+	lookup := New(holidayStub{}).For("", "")
+
+	tests := []struct {
+		from    string
+		want    string
+		skipped int
+	}{
+		{"2025-07-04", "2025-07-07", 2},
+		// Sexta-feira Santa, fim de semana e Tiradentes
+		{"2025-04-17", "2025-04-22", 4},
+		// Do último dia útil antes do recesso para o primeiro depois dele
+		{"2025-12-19", "2026-01-21", 32},
+	}
+
+	for _, tt := range tests {
+		got, skipped, err := lookup.NextWorkingDay(date(tt.from))
+		if err != nil {
+			t.Fatalf("NextWorkingDay(%s): %v", tt.from, err)
+		}
+		if got.Format(DateLayout) != tt.want || len(skipped) != tt.skipped {
+			t.Errorf("NextWorkingDay(%s) = %s com %d dias saltados, esperado %s com %d",
+				tt.from, got.Format(DateLayout), len(skipped), tt.want, tt.skipped)
+		}
+	}
+}
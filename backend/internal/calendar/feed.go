@@ -0,0 +1,112 @@
+package calendar
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ICSContentType é o tipo MIME de arquivos iCalendar
+const ICSContentType = "text/calendar; charset=utf-8"
+
+const (
+	icsDateLayout     = "20060102"
+	icsDateTimeLayout = "20060102T150405Z"
+
+	// icsLineLimit é o tamanho máximo de linha em octetos (RFC 5545, 3.1)
+	icsLineLimit = 75
+)
+
+// Status de eventos do iCalendar
+const (
+	FeedStatusConfirmed = "CONFIRMED"
+	FeedStatusCancelled = "CANCELLED"
+)
+
+// FeedEvent é um evento exportado para o feed iCalendar. Eventos de dia
+// inteiro (AllDay) usam apenas a data de Start.
+type FeedEvent struct {
+	UID          string
+	Summary      string
+	Description  string
+	Location     string
+	Start        time.Time
+	End          time.Time
+	AllDay       bool
+	Status       string
+	LastModified time.Time
+}
+
+// WriteFeed grava os eventos como um VCALENDAR (RFC 5545) com o nome informado
+func WriteFeed(w io.Writer, name string, events []FeedEvent) error {
+	buf := bufio.NewWriter(w)
+	line := func(content string) {
+		writeICSLine(buf, content)
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//JurisConnect//Agenda//PT-BR")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:" + escapeICSText(name))
+	line("X-WR-TIMEZONE:America/Sao_Paulo")
+
+	stamp := time.Now().UTC().Format(icsDateTimeLayout)
+	for _, event := range events {
+		line("BEGIN:VEVENT")
+		line("UID:" + event.UID)
+		line("DTSTAMP:" + stamp)
+		if event.AllDay {
+			// DTEND de eventos de dia inteiro é exclusivo
+			line("DTSTART;VALUE=DATE:" + event.Start.Format(icsDateLayout))
+			line("DTEND;VALUE=DATE:" + event.Start.AddDate(0, 0, 1).Format(icsDateLayout))
+		} else {
+			line("DTSTART:" + event.Start.UTC().Format(icsDateTimeLayout))
+			line("DTEND:" + event.End.UTC().Format(icsDateTimeLayout))
+		}
+		line("SUMMARY:" + escapeICSText(event.Summary))
+		if event.Description != "" {
+			line("DESCRIPTION:" + escapeICSText(event.Description))
+		}
+		if event.Location != "" {
+			line("LOCATION:" + escapeICSText(event.Location))
+		}
+		if event.Status != "" {
+			line("STATUS:" + event.Status)
+		}
+		if !event.LastModified.IsZero() {
+			line("LAST-MODIFIED:" + event.LastModified.UTC().Format(icsDateTimeLayout))
+		}
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+
+	return buf.Flush()
+}
+
+// writeICSLine grava a linha terminada em CRLF, dobrando-a em linhas de até
+// 75 octetos sem partir caracteres UTF-8
+func writeICSLine(w *bufio.Writer, content string) {
+	limit := icsLineLimit
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		w.WriteString(content[:cut])
+		w.WriteString("\r\n ")
+		content = content[cut:]
+		// Linhas de continuação começam com um espaço
+		limit = icsLineLimit - 1
+	}
+	w.WriteString(content)
+	w.WriteString("\r\n")
+}
+
+func escapeICSText(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	return replacer.Replace(value)
+}
@@ -0,0 +1,214 @@
+package calendar
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/domain"
+)
+
+// Formatos de arquivo aceitos na importação de feriados
+const (
+	FormatICS = "ics"
+	FormatCSV = "csv"
+)
+
+// ParseICS lê os eventos (VEVENT) de um arquivo iCalendar (RFC 5545). Eventos
+// de dia inteiro usam DTEND exclusivo; RRULE com FREQ=YEARLY torna o feriado
+// recorrente.
+func ParseICS(r io.Reader) ([]domain.Holiday, error) {
+	lines, err := unfoldICSLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays := []domain.Holiday{}
+	var current *domain.Holiday
+	var end time.Time
+	for number, line := range lines {
+		name, params, value := splitICSProperty(line)
+		switch name {
+		case "BEGIN":
+			if strings.EqualFold(value, "VEVENT") {
+				current = &domain.Holiday{}
+				end = time.Time{}
+			}
+		case "END":
+			if strings.EqualFold(value, "VEVENT") && current != nil {
+				if current.Date.IsZero() {
+					return nil, fmt.Errorf("evento sem DTSTART antes da linha %d", number+1)
+				}
+				current.EndDate = current.Date
+				// DTEND de eventos de dia inteiro é exclusivo
+				if !end.IsZero() && end.After(current.Date) {
+					current.EndDate = end.AddDate(0, 0, -1)
+				}
+				holidays = append(holidays, *current)
+				current = nil
+			}
+		case "DTSTART", "DTEND":
+			if current == nil {
+				continue
+			}
+			date, err := parseICSDate(value, params)
+			if err != nil {
+				return nil, fmt.Errorf("linha %d: %v", number+1, err)
+			}
+			if name == "DTSTART" {
+				current.Date = date
+			} else {
+				end = date
+			}
+		case "SUMMARY":
+			if current != nil {
+				current.Name = unescapeICSText(value)
+			}
+		case "RRULE":
+			if current != nil && strings.Contains(strings.ToUpper(value), "FREQ=YEARLY") {
+				current.Recurring = true
+			}
+		}
+	}
+
+	return holidays, nil
+}
+
+// unfoldICSLines junta as linhas continuadas (iniciadas por espaço ou tab)
+func unfoldICSLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	lines := []string{}
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// splitICSProperty separa "NOME;PARAM=X:valor" em nome, parâmetros e valor
+func splitICSProperty(line string) (string, string, string) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return "", "", ""
+	}
+	head, value := line[:colon], line[colon+1:]
+	name, params, _ := strings.Cut(head, ";")
+	return strings.ToUpper(name), params, value
+}
+
+func parseICSDate(value, params string) (time.Time, error) {
+	// Datas com horário (VALUE=DATE-TIME) valem pelo dia
+	if len(value) >= 8 {
+		if date, err := time.Parse("20060102", value[:8]); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("data inválida %q (%s)", value, params)
+}
+
+func unescapeICSText(value string) string {
+	replacer := strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return strings.TrimSpace(replacer.Replace(value))
+}
+
+// ParseCSV lê feriados de um CSV com cabeçalho. As colunas date e name são
+// obrigatórias; end_date, kind, scope, state, court e recurring são opcionais.
+// Datas são aceitas como AAAA-MM-DD ou DD/MM/AAAA e o separador pode ser
+// vírgula ou ponto e vírgula.
+func ParseCSV(r io.Reader) ([]domain.Holiday, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	text := strings.TrimPrefix(string(content), "\ufeff")
+
+	reader := csv.NewReader(strings.NewReader(text))
+	firstLine, _, _ := strings.Cut(text, "\n")
+	if strings.Count(firstLine, ";") > strings.Count(firstLine, ",") {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("arquivo CSV vazio")
+	}
+
+	columns := make(map[string]int)
+	for i, header := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(header))] = i
+	}
+	if _, ok := columns["date"]; !ok {
+		return nil, errors.New("coluna \"date\" ausente no CSV")
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, errors.New("coluna \"name\" ausente no CSV")
+	}
+
+	field := func(record []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	holidays := []domain.Holiday{}
+	for number, record := range records[1:] {
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		line := number + 2
+
+		date, err := parseCSVDate(field(record, "date"))
+		if err != nil {
+			return nil, fmt.Errorf("linha %d: %v", line, err)
+		}
+		holiday := domain.Holiday{
+			Name:      field(record, "name"),
+			Kind:      domain.HolidayKind(strings.ToLower(field(record, "kind"))),
+			Date:      date,
+			EndDate:   date,
+			Scope:     domain.HolidayScope(strings.ToLower(field(record, "scope"))),
+			State:     field(record, "state"),
+			Court:     field(record, "court"),
+			Recurring: parseBool(field(record, "recurring")),
+		}
+		if value := field(record, "end_date"); value != "" {
+			if holiday.EndDate, err = parseCSVDate(value); err != nil {
+				return nil, fmt.Errorf("linha %d: %v", line, err)
+			}
+		}
+		holidays = append(holidays, holiday)
+	}
+
+	return holidays, nil
+}
+
+func parseCSVDate(value string) (time.Time, error) {
+	for _, layout := range []string{DateLayout, "02/01/2006"} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("data inválida %q, use AAAA-MM-DD ou DD/MM/AAAA", value)
+}
+
+func parseBool(value string) bool {
+	switch strings.ToLower(value) {
+	case "true", "1", "sim", "s", "yes":
+		return true
+	}
+	return false
+}
@@ -2,6 +2,8 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -9,12 +11,25 @@ type Config struct {
 	Server  ServerConfig
 	MongoDB MongoDBConfig
 	JWT     JWTConfig
+	Storage StorageConfig
+	Pix     PixConfig
+	Invoice InvoiceConfig
+	Trash   TrashConfig
+	Mail    MailConfig
+	Login   LoginConfig
+	Reset   PasswordResetConfig
 }
 
 type ServerConfig struct {
 	Port         string
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
+	// TrustedProxies são os proxies (IPs ou CIDRs) cujo X-Forwarded-For é
+	// aceito para identificar o IP do cliente; vazio, vale o IP da conexão
+	TrustedProxies []string
+	// TrustedPlatform é o header com o IP do cliente definido pela plataforma
+	// de hospedagem (ex.: "CF-Connecting-IP"), se houver
+	TrustedPlatform string
 }
 
 type MongoDBConfig struct {
@@ -24,16 +39,91 @@ type MongoDBConfig struct {
 }
 
 type JWTConfig struct {
-	Secret string
-	Expiry time.Duration
+	Secret        string
+	Expiry        time.Duration
+	RefreshExpiry time.Duration
+}
+
+type StorageConfig struct {
+	Driver        string
+	LocalPath     string
+	MaxUploadSize int64
+	S3            S3Config
+}
+
+// PixConfig identifica o recebedor nas cobranças Pix das faturas
+type PixConfig struct {
+	Key          string
+	MerchantName string
+	MerchantCity string
+}
+
+// InvoiceConfig define a frequência com que as faturas emitidas e não pagas
+// no vencimento passam a vencidas; zero desativa a rotina
+type InvoiceConfig struct {
+	OverdueInterval time.Duration
+}
+
+// TrashConfig define por quanto tempo os registros excluídos ficam na
+// lixeira e a frequência da limpeza; retenção zero desativa a limpeza
+type TrashConfig struct {
+	Retention     time.Duration
+	PurgeInterval time.Duration
+}
+
+// MailConfig define como os e-mails do sistema são enviados ("log" ou "smtp")
+type MailConfig struct {
+	Driver string
+	From   string
+	SMTP   SMTPConfig
+}
+
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+}
+
+// LoginConfig define a proteção contra tentativas de senha: após MaxAttempts
+// falhas dentro de AttemptWindow a conta (ou o IP) fica bloqueada por
+// Lockout, tempo que dobra a cada novo bloqueio até MaxLockout
+type LoginConfig struct {
+	MaxAttempts      int
+	MaxAttemptsPerIP int
+	AttemptWindow    time.Duration
+	Lockout          time.Duration
+	MaxLockout       time.Duration
+}
+
+// PasswordResetConfig define o link enviado no e-mail de redefinição de
+// senha (o token é acrescentado como ?token=), a validade do token e quantos
+// pedidos cada email e cada IP podem fazer dentro de RequestWindow
+type PasswordResetConfig struct {
+	URL              string
+	Expiry           time.Duration
+	MaxRequests      int
+	MaxRequestsPerIP int
+	RequestWindow    time.Duration
+}
+
+type S3Config struct {
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	Bucket    string
+	Region    string
+	UseSSL    bool
 }
 
 func Load() *Config {
 	return &Config{
 		Server: ServerConfig{
-			Port:         getEnv("PORT", "8080"),
-			ReadTimeout:  time.Second * 15,
-			WriteTimeout: time.Second * 15,
+			Port:            getEnv("PORT", "8080"),
+			ReadTimeout:     time.Second * 15,
+			WriteTimeout:    time.Second * 15,
+			TrustedProxies:  getListEnv("TRUSTED_PROXIES"),
+			TrustedPlatform: getEnv("TRUSTED_PLATFORM", ""),
 		},
 		MongoDB: MongoDBConfig{
 			URI:      getEnv("MONGODB_URI", "mongodb://localhost:27017"),
@@ -41,8 +131,58 @@ func Load() *Config {
 			Timeout:  time.Second * 10,
 		},
 		JWT: JWTConfig{
-			Secret: getEnv("JWT_SECRET", "your-secret-key"),
-			Expiry: time.Hour * 24,
+			Secret:        getEnv("JWT_SECRET", "your-secret-key"),
+			Expiry:        getDurationEnv("JWT_EXPIRATION", time.Minute*15),
+			RefreshExpiry: getDurationEnv("JWT_REFRESH_EXPIRATION", time.Hour*24*7),
+		},
+		Storage: StorageConfig{
+			Driver:        getEnv("STORAGE_DRIVER", "local"),
+			LocalPath:     getEnv("STORAGE_LOCAL_PATH", "./storage"),
+			MaxUploadSize: getInt64Env("STORAGE_MAX_UPLOAD_SIZE", 50<<20),
+			S3: S3Config{
+				Endpoint:  getEnv("S3_ENDPOINT", "localhost:9000"),
+				AccessKey: getEnv("S3_ACCESS_KEY", ""),
+				SecretKey: getEnv("S3_SECRET_KEY", ""),
+				Bucket:    getEnv("S3_BUCKET", "jurisconnect-documents"),
+				Region:    getEnv("S3_REGION", "us-east-1"),
+				UseSSL:    getEnv("S3_USE_SSL", "false") == "true",
+			},
+		},
+		Pix: PixConfig{
+			Key:          getEnv("PIX_KEY", ""),
+			MerchantName: getEnv("PIX_MERCHANT_NAME", "JurisConnect"),
+			MerchantCity: getEnv("PIX_MERCHANT_CITY", "Sao Paulo"),
+		},
+		Invoice: InvoiceConfig{
+			OverdueInterval: getDurationEnv("INVOICE_OVERDUE_INTERVAL", time.Hour),
+		},
+		Trash: TrashConfig{
+			Retention:     getDurationEnv("TRASH_RETENTION", time.Hour*24*30),
+			PurgeInterval: getDurationEnv("TRASH_PURGE_INTERVAL", time.Hour*6),
+		},
+		Mail: MailConfig{
+			Driver: getEnv("MAIL_DRIVER", "log"),
+			From:   getEnv("MAIL_FROM", "JurisConnect <nao-responda@jurisconnect.com.br>"),
+			SMTP: SMTPConfig{
+				Host:     getEnv("SMTP_HOST", ""),
+				Port:     getEnv("SMTP_PORT", "587"),
+				Username: getEnv("SMTP_USERNAME", ""),
+				Password: getEnv("SMTP_PASSWORD", ""),
+			},
+		},
+		Login: LoginConfig{
+			MaxAttempts:      int(getInt64Env("LOGIN_MAX_ATTEMPTS", 5)),
+			MaxAttemptsPerIP: int(getInt64Env("LOGIN_MAX_ATTEMPTS_PER_IP", 20)),
+			AttemptWindow:    getDurationEnv("LOGIN_ATTEMPT_WINDOW", time.Minute*15),
+			Lockout:          getDurationEnv("LOGIN_LOCKOUT", time.Minute*15),
+			MaxLockout:       getDurationEnv("LOGIN_MAX_LOCKOUT", time.Hour*24),
+		},
+		Reset: PasswordResetConfig{
+			URL:              getEnv("PASSWORD_RESET_URL", "http://localhost:5173/redefinir-senha"),
+			Expiry:           getDurationEnv("PASSWORD_RESET_EXPIRATION", time.Hour),
+			MaxRequests:      int(getInt64Env("PASSWORD_RESET_MAX_REQUESTS", 3)),
+			MaxRequestsPerIP: int(getInt64Env("PASSWORD_RESET_MAX_REQUESTS_PER_IP", 10)),
+			RequestWindow:    getDurationEnv("PASSWORD_RESET_REQUEST_WINDOW", time.Hour),
 		},
 	}
 }
@@ -53,3 +193,32 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getListEnv lê uma lista separada por vírgulas; ausente, retorna nil
+func getListEnv(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return defaultValue
+}
+
+func getInt64Env(key string, defaultValue int64) int64 {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,6 +11,12 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// Códigos de erro do MongoDB tratados ao remover índices
+const (
+	errNamespaceNotFound = 26
+	errIndexNotFound     = 27
+)
+
 type MongoDB struct {
 	Client   *mongo.Client
 	Database *mongo.Database
@@ -50,3 +57,32 @@ func (m *MongoDB) Ping() error {
 	defer cancel()
 	return m.Client.Ping(ctx, nil)
 }
+
+// EnsureIndexes cria os índices informados na coleção, ignorando os já existentes
+func (m *MongoDB) EnsureIndexes(collection string, models ...mongo.IndexModel) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := m.Database.Collection(collection).Indexes().CreateMany(ctx, models)
+	if err != nil {
+		return fmt.Errorf("falha ao criar índices em %s: %v", collection, err)
+	}
+	return nil
+}
+
+// DropIndexes remove índices substituídos por outros, ignorando os que não
+// existem (ou cuja coleção ainda não existe)
+func (m *MongoDB) DropIndexes(collection string, names ...string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for _, name := range names {
+		_, err := m.Database.Collection(collection).Indexes().DropOne(ctx, name)
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && (cmdErr.Code == errNamespaceNotFound || cmdErr.Code == errIndexNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("falha ao remover o índice %s de %s: %v", name, collection, err)
+		}
+	}
+	return nil
+}
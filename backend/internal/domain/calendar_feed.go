@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CalendarFeed é uma assinatura iCalendar dos prazos e compromissos do
+// usuário ou, quando CaseID é informado, de um processo. O token da URL é
+// independente do login e apenas o seu hash é persistido.
+type CalendarFeed struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID         primitive.ObjectID `bson:"user_id" json:"user_id"`
+	CaseID         primitive.ObjectID `bson:"case_id,omitempty" json:"case_id,omitempty"`
+	Name           string             `bson:"name" json:"name"`
+	TokenHash      string             `bson:"token_hash" json:"-"`
+	LastAccessedAt *time.Time         `bson:"last_accessed_at,omitempty" json:"last_accessed_at,omitempty"`
+	RevokedAt      *time.Time         `bson:"revoked_at,omitempty" json:"revoked_at,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at" json:"created_at"`
+}
+
+type CalendarFeedRepository interface {
+	Create(feed *CalendarFeed) error
+	FindByID(id string) (*CalendarFeed, error)
+	FindByTokenHash(hash string) (*CalendarFeed, error)
+	FindByUserID(userID string) ([]*CalendarFeed, error)
+	Revoke(id string) error
+	RevokeAllByUser(userID string) error
+	Touch(id primitive.ObjectID, at time.Time) error
+}
+
+type CalendarFeedService interface {
+	Create(feed *CalendarFeed) (string, error)
+	ListByUser(userID string) ([]*CalendarFeed, error)
+	Revoke(userID, feedID string) error
+	RevokeAll(userID string) error
+	Render(token string) ([]byte, error)
+}
@@ -8,29 +8,63 @@ import (
 
 type Case struct {
 	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Number      string             `bson:"number,omitempty" json:"number,omitempty"`
+	NumberInfo  *CNJInfo           `bson:"number_info,omitempty" json:"number_info,omitempty"`
 	Title       string             `bson:"title" json:"title"`
 	Description string             `bson:"description" json:"description"`
-	Status      string             `bson:"status" json:"status"`
+	Status      CaseStatus         `bson:"status" json:"status"`
 	ClientID    primitive.ObjectID `bson:"client_id" json:"client_id"`
 	LawyerID    primitive.ObjectID `bson:"lawyer_id" json:"lawyer_id"`
-	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+	Parties     []CaseParty        `bson:"parties" json:"parties"`
+	Team        []CaseTeamMember   `bson:"team" json:"team"`
+	// ConflictOverride é preenchido quando o processo foi cadastrado apesar de conflito de interesses
+	ConflictOverride *ConflictOverride  `bson:"conflict_override,omitempty" json:"conflict_override,omitempty"`
+	CreatedBy        primitive.ObjectID `bson:"created_by,omitempty" json:"created_by,omitempty"`
+	CreatedAt        time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt        time.Time          `bson:"updated_at" json:"updated_at"`
+	DeletedAt        *time.Time         `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy        primitive.ObjectID `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
+}
+
+// CNJInfo contém os componentes do número único de processo (Resolução CNJ nº 65/2008)
+type CNJInfo struct {
+	Sequential  string `bson:"sequential" json:"sequential"`
+	CheckDigits string `bson:"check_digits" json:"check_digits"`
+	Year        int    `bson:"year" json:"year"`
+	Segment     int    `bson:"segment" json:"segment"`
+	SegmentName string `bson:"segment_name" json:"segment_name"`
+	Court       string `bson:"court" json:"court"`
+	Origin      string `bson:"origin" json:"origin"`
 }
 
 type CaseRepository interface {
 	Create(case_ *Case) error
 	FindByID(id string) (*Case, error)
+	FindByNumber(number string) (*Case, error)
 	FindByClientID(clientID string) ([]*Case, error)
 	FindByLawyerID(lawyerID string) ([]*Case, error)
+	FindByParty(query PartyQuery) ([]*Case, error)
+	FindByTeamMember(userID string) ([]*Case, error)
+	FindConflictCandidates(document string, nameTokens []string) ([]*Case, error)
 	Update(case_ *Case) error
-	Delete(id string) error
+	UpdateStatus(id string, from, to CaseStatus) error
+	Delete(id string, deletedBy primitive.ObjectID) error
+	Restore(id string) error
+	FindDeleted(deletedBefore time.Time) ([]*Case, error)
+	FindDeletedByID(id string) (*Case, error)
+	Purge(id string) error
 }
 
 type CaseService interface {
 	Create(case_ *Case) error
 	GetByID(id string) (*Case, error)
+	GetByNumber(number string) (*Case, error)
 	GetByClientID(clientID string) ([]*Case, error)
 	GetByLawyerID(lawyerID string) ([]*Case, error)
-	Update(case_ *Case) error
-	Delete(id string) error
+	GetByParty(query PartyQuery) ([]*Case, error)
+	GetByTeamMember(userID string) ([]*Case, error)
+	Update(case_ *Case, override *ConflictOverride, updatedBy primitive.ObjectID) error
+	TransitionStatus(id string, to CaseStatus, reason string, changedBy primitive.ObjectID) (*Case, error)
+	GetStatusHistory(id string, status CaseStatus) ([]*CaseStatusChange, error)
+	Delete(id string, deletedBy primitive.ObjectID) error
 }
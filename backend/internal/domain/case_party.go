@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PartySide string
+
+// Posição da parte no processo
+const (
+	PartySidePlaintiff  PartySide = "polo_ativo"
+	PartySideDefendant  PartySide = "polo_passivo"
+	PartySideThirdParty PartySide = "terceiro_interessado"
+)
+
+// IsValid informa se a posição processual é conhecida
+func (s PartySide) IsValid() bool {
+	switch s {
+	case PartySidePlaintiff, PartySideDefendant, PartySideThirdParty:
+		return true
+	}
+	return false
+}
+
+// PartyCounsel é um advogado que representa a parte (inclusive os da parte contrária)
+type PartyCounsel struct {
+	Name      string `bson:"name" json:"name"`
+	OABNumber string `bson:"oab_number" json:"oab_number"`
+	OABState  string `bson:"oab_state" json:"oab_state"`
+}
+
+// CaseParty é uma parte do processo. ClientID é preenchido quando a parte é
+// cliente do escritório; Document guarda o CPF/CNPJ apenas com dígitos.
+type CaseParty struct {
+	Name       string             `bson:"name" json:"name"`
+	SearchName string             `bson:"search_name,omitempty" json:"-"`
+	Document   string             `bson:"document,omitempty" json:"document,omitempty"`
+	ClientID   primitive.ObjectID `bson:"client_id,omitempty" json:"client_id,omitempty"`
+	Side       PartySide          `bson:"side" json:"side"`
+	Counsel    []PartyCounsel     `bson:"counsel,omitempty" json:"counsel,omitempty"`
+}
+
+type CaseTeamRole string
+
+// Papel do integrante da equipe do escritório no processo
+const (
+	CaseTeamRoleResponsible  CaseTeamRole = "responsavel"
+	CaseTeamRoleCollaborator CaseTeamRole = "colaborador"
+	CaseTeamRoleIntern       CaseTeamRole = "estagiario"
+)
+
+// IsValid informa se o papel na equipe é conhecido
+func (r CaseTeamRole) IsValid() bool {
+	switch r {
+	case CaseTeamRoleResponsible, CaseTeamRoleCollaborator, CaseTeamRoleIntern:
+		return true
+	}
+	return false
+}
+
+// CaseTeamMember é um usuário do escritório designado para o processo
+type CaseTeamMember struct {
+	UserID     primitive.ObjectID `bson:"user_id" json:"user_id"`
+	Role       CaseTeamRole       `bson:"role" json:"role"`
+	AssignedAt time.Time          `bson:"assigned_at" json:"assigned_at"`
+}
+
+// PartyQuery filtra processos pelas partes envolvidas; campos vazios são ignorados
+type PartyQuery struct {
+	Document string
+	Name     string
+	ClientID string
+	Side     PartySide
+}
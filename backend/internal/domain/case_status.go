@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CaseStatus string
+
+// Ciclo de vida do processo
+const (
+	CaseStatusNew             CaseStatus = "novo"
+	CaseStatusInProgress      CaseStatus = "em_andamento"
+	CaseStatusAwaitingHearing CaseStatus = "aguardando_audiencia"
+	CaseStatusSuspended       CaseStatus = "suspenso"
+	CaseStatusOnAppeal        CaseStatus = "em_recurso"
+	CaseStatusConcluded       CaseStatus = "concluido"
+	CaseStatusArchived        CaseStatus = "arquivado"
+)
+
+// CaseStatusTransitions define, para cada status, os próximos status permitidos
+var CaseStatusTransitions = map[CaseStatus][]CaseStatus{
+	CaseStatusNew:             {CaseStatusInProgress, CaseStatusSuspended, CaseStatusArchived},
+	CaseStatusInProgress:      {CaseStatusAwaitingHearing, CaseStatusSuspended, CaseStatusOnAppeal, CaseStatusConcluded},
+	CaseStatusAwaitingHearing: {CaseStatusInProgress, CaseStatusSuspended, CaseStatusOnAppeal, CaseStatusConcluded},
+	CaseStatusSuspended:       {CaseStatusInProgress, CaseStatusAwaitingHearing, CaseStatusArchived},
+	CaseStatusOnAppeal:        {CaseStatusInProgress, CaseStatusSuspended, CaseStatusConcluded},
+	CaseStatusConcluded:       {CaseStatusOnAppeal, CaseStatusArchived},
+	CaseStatusArchived:        {CaseStatusInProgress},
+}
+
+// IsValid informa se o status faz parte do ciclo de vida
+func (s CaseStatus) IsValid() bool {
+	_, ok := CaseStatusTransitions[s]
+	return ok
+}
+
+// IsOpen informa se o processo ainda está em curso (não concluído nem arquivado)
+func (s CaseStatus) IsOpen() bool {
+	return s != CaseStatusConcluded && s != CaseStatusArchived
+}
+
+// CanTransitionTo informa se a mudança de status é permitida
+func (s CaseStatus) CanTransitionTo(next CaseStatus) bool {
+	for _, allowed := range CaseStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// CaseStatusChange registra uma mudança de status do processo. Os registros
+// são apenas inseridos, nunca alterados.
+type CaseStatusChange struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	CaseID     primitive.ObjectID `bson:"case_id" json:"case_id"`
+	FromStatus CaseStatus         `bson:"from_status,omitempty" json:"from_status,omitempty"`
+	ToStatus   CaseStatus         `bson:"to_status" json:"to_status"`
+	Reason     string             `bson:"reason" json:"reason"`
+	ChangedBy  primitive.ObjectID `bson:"changed_by" json:"changed_by"`
+	ChangedAt  time.Time          `bson:"changed_at" json:"changed_at"`
+}
+
+type CaseStatusHistoryRepository interface {
+	Create(change *CaseStatusChange) error
+	FindByCaseID(caseID string, status CaseStatus) ([]*CaseStatusChange, error)
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ClientType distingue pessoas físicas (CPF) de pessoas jurídicas (CNPJ)
+type ClientType string
+
+const (
+	ClientTypeIndividual ClientType = "pf"
+	ClientTypeCompany    ClientType = "pj"
+)
+
+// IsValid informa se o tipo de cliente é conhecido
+func (t ClientType) IsValid() bool {
+	return t == ClientTypeIndividual || t == ClientTypeCompany
+}
+
+// Client é o cliente do escritório. Para pessoas físicas, Name é o nome
+// completo e Document o CPF; para pessoas jurídicas, Name é a razão social e
+// Document o CNPJ. Documentos são armazenados apenas com dígitos.
+type Client struct {
+	ID                primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Type              ClientType         `bson:"type" json:"type"`
+	Name              string             `bson:"name" json:"name"`
+	SearchName        string             `bson:"search_name" json:"-"`
+	TradeName         string             `bson:"trade_name,omitempty" json:"trade_name,omitempty"`
+	Document          string             `bson:"document" json:"document"`
+	RG                string             `bson:"rg,omitempty" json:"rg,omitempty"`
+	BirthDate         time.Time          `bson:"birth_date,omitempty" json:"birth_date,omitempty"`
+	StateRegistration string             `bson:"state_registration,omitempty" json:"state_registration,omitempty"`
+	Email             string             `bson:"email" json:"email"`
+	Phone             string             `bson:"phone" json:"phone"`
+	Contacts          []ClientContact    `bson:"contacts" json:"contacts"`
+	Addresses         []Address          `bson:"addresses" json:"addresses"`
+	Notes             string             `bson:"notes" json:"notes"`
+	// HourlyRateCents é o valor da hora acordado com o cliente, em centavos;
+	// quando informado, prevalece sobre o valor da hora do profissional
+	HourlyRateCents  int64              `bson:"hourly_rate_cents,omitempty" json:"hourly_rate_cents,omitempty"`
+	IsActive         bool               `bson:"is_active" json:"is_active"`
+	ConflictOverride *ConflictOverride  `bson:"conflict_override,omitempty" json:"conflict_override,omitempty"`
+	CreatedBy        primitive.ObjectID `bson:"created_by" json:"created_by"`
+	CreatedAt        time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt        time.Time          `bson:"updated_at" json:"updated_at"`
+	DeletedAt        *time.Time         `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy        primitive.ObjectID `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
+}
+
+// ClientContact é uma pessoa de contato do cliente (ex.: sócio, gerente jurídico)
+type ClientContact struct {
+	Name     string `bson:"name" json:"name"`
+	Position string `bson:"position" json:"position"`
+	Email    string `bson:"email" json:"email"`
+	Phone    string `bson:"phone" json:"phone"`
+}
+
+// ClientQuery reúne os filtros da busca de clientes; campos vazios são ignorados
+type ClientQuery struct {
+	Name     string
+	Document string
+	Type     ClientType
+}
+
+type ClientRepository interface {
+	Create(client *Client) error
+	FindByID(id string) (*Client, error)
+	FindByDocument(document string) (*Client, error)
+	Search(query ClientQuery) ([]*Client, error)
+	FindConflictCandidates(document string, nameTokens []string) ([]*Client, error)
+	Update(client *Client) error
+	Delete(id string, deletedBy primitive.ObjectID) error
+	Restore(id string) error
+	FindDeleted(deletedBefore time.Time) ([]*Client, error)
+	FindDeletedByID(id string) (*Client, error)
+	Purge(id string) error
+}
+
+type ClientService interface {
+	Create(client *Client) error
+	GetByID(id string) (*Client, error)
+	GetByDocument(document string) (*Client, error)
+	Search(query ClientQuery) ([]*Client, error)
+	Update(client *Client, override *ConflictOverride, updatedBy primitive.ObjectID) error
+	Delete(id string, deletedBy primitive.ObjectID) error
+}
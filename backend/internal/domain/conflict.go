@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ConflictRole indica de que lado o escritório ficará em relação à pessoa verificada
+type ConflictRole string
+
+const (
+	// ConflictRoleClient é alguém que o escritório passará a representar
+	ConflictRoleClient ConflictRole = "client"
+	// ConflictRoleOpposing é alguém contra quem o escritório atuará
+	ConflictRoleOpposing ConflictRole = "opposing"
+)
+
+// IsValid informa se o papel é conhecido
+func (r ConflictRole) IsValid() bool {
+	return r == ConflictRoleClient || r == ConflictRoleOpposing
+}
+
+// Tipos de coincidência encontrados na verificação de conflito
+const (
+	ConflictMatchDocument    = "document"
+	ConflictMatchExactName   = "exact_name"
+	ConflictMatchSimilarName = "similar_name"
+)
+
+// Origens dos registros coincidentes
+const (
+	ConflictSourceClient    = "client"
+	ConflictSourceCaseParty = "case_party"
+)
+
+// Tipos de cadastro protegidos pela verificação de conflito
+const (
+	ConflictTargetClient = "client"
+	ConflictTargetCase   = "case"
+)
+
+// ConflictSubject é uma pessoa (física ou jurídica) a ser verificada
+type ConflictSubject struct {
+	Name     string       `bson:"name" json:"name"`
+	Document string       `bson:"document,omitempty" json:"document,omitempty"`
+	Role     ConflictRole `bson:"role" json:"role"`
+}
+
+// ConflictMatch é um registro existente que coincide com a pessoa verificada.
+// Relation indica de que lado o escritório esteve em relação a esse registro;
+// Conflict é verdadeiro quando os lados se opõem.
+type ConflictMatch struct {
+	Subject    ConflictSubject    `bson:"subject" json:"subject"`
+	Source     string             `bson:"source" json:"source"`
+	MatchType  string             `bson:"match_type" json:"match_type"`
+	Score      float64            `bson:"score" json:"score"`
+	Relation   ConflictRole       `bson:"relation" json:"relation"`
+	Conflict   bool               `bson:"conflict" json:"conflict"`
+	Name       string             `bson:"name" json:"name"`
+	Document   string             `bson:"document,omitempty" json:"document,omitempty"`
+	ClientID   primitive.ObjectID `bson:"client_id,omitempty" json:"client_id,omitempty"`
+	CaseID     primitive.ObjectID `bson:"case_id,omitempty" json:"case_id,omitempty"`
+	CaseNumber string             `bson:"case_number,omitempty" json:"case_number,omitempty"`
+	CaseTitle  string             `bson:"case_title,omitempty" json:"case_title,omitempty"`
+	PartySide  PartySide          `bson:"party_side,omitempty" json:"party_side,omitempty"`
+}
+
+// ConflictReport é o resultado da verificação de conflito de interesses
+type ConflictReport struct {
+	Subjects     []ConflictSubject `json:"subjects"`
+	Matches      []ConflictMatch   `json:"matches"`
+	HasConflicts bool              `json:"has_conflicts"`
+	CheckedAt    time.Time         `json:"checked_at"`
+}
+
+// ConflictOverride registra a ciência expressa de um conflito ao cadastrar um
+// cliente ou processo mesmo assim
+type ConflictOverride struct {
+	Acknowledged   bool               `bson:"acknowledged" json:"acknowledged"`
+	Reason         string             `bson:"reason" json:"reason"`
+	CheckID        primitive.ObjectID `bson:"check_id" json:"check_id"`
+	AcknowledgedBy primitive.ObjectID `bson:"acknowledged_by" json:"acknowledged_by"`
+	AcknowledgedAt time.Time          `bson:"acknowledged_at" json:"acknowledged_at"`
+}
+
+// ConflictCheck é o registro de auditoria de um conflito aceito por override
+type ConflictCheck struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	TargetType string             `bson:"target_type" json:"target_type"`
+	TargetID   primitive.ObjectID `bson:"target_id,omitempty" json:"target_id,omitempty"`
+	Subjects   []ConflictSubject  `bson:"subjects" json:"subjects"`
+	Matches    []ConflictMatch    `bson:"matches" json:"matches"`
+	Reason     string             `bson:"reason" json:"reason"`
+	CheckedBy  primitive.ObjectID `bson:"checked_by" json:"checked_by"`
+	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
+}
+
+type ConflictCheckRepository interface {
+	Create(check *ConflictCheck) error
+	FindAll() ([]*ConflictCheck, error)
+	FindByTarget(targetType, targetID string) ([]*ConflictCheck, error)
+}
+
+type ConflictService interface {
+	Check(subjects []ConflictSubject) (*ConflictReport, error)
+	CheckClient(client *Client) (*ConflictReport, error)
+	CheckCase(case_ *Case) (*ConflictReport, error)
+	ListOverrides(targetType, targetID string) ([]*ConflictCheck, error)
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// DeadlineStartEvent é o ato que dispara a contagem do prazo
+type DeadlineStartEvent string
+
+const (
+	DeadlineStartIntimation   DeadlineStartEvent = "intimacao"
+	DeadlineStartPublication  DeadlineStartEvent = "publicacao"
+	DeadlineStartAvailability DeadlineStartEvent = "disponibilizacao"
+)
+
+// IsValid informa se o evento de início é conhecido
+func (e DeadlineStartEvent) IsValid() bool {
+	switch e {
+	case DeadlineStartIntimation, DeadlineStartPublication, DeadlineStartAvailability:
+		return true
+	}
+	return false
+}
+
+// DeadlineCountingMode define se o prazo corre em dias úteis (prazos
+// processuais, CPC art. 219) ou em dias corridos
+type DeadlineCountingMode string
+
+const (
+	DeadlineCountingBusinessDays DeadlineCountingMode = "dias_uteis"
+	DeadlineCountingCalendarDays DeadlineCountingMode = "dias_corridos"
+)
+
+// IsValid informa se o modo de contagem é conhecido
+func (m DeadlineCountingMode) IsValid() bool {
+	return m == DeadlineCountingBusinessDays || m == DeadlineCountingCalendarDays
+}
+
+type DeadlineStatus string
+
+const (
+	DeadlineStatusPending   DeadlineStatus = "pendente"
+	DeadlineStatusDone      DeadlineStatus = "cumprido"
+	DeadlineStatusCancelled DeadlineStatus = "cancelado"
+)
+
+// IsValid informa se o status do prazo é conhecido
+func (s DeadlineStatus) IsValid() bool {
+	switch s {
+	case DeadlineStatusPending, DeadlineStatusDone, DeadlineStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// DeadlineCalculation são os parâmetros do cálculo do prazo. State e Court
+// selecionam os feriados locais; Doubled conta o prazo em dobro (Fazenda
+// Pública, Defensoria, litisconsortes com procuradores distintos).
+type DeadlineCalculation struct {
+	StartEvent   DeadlineStartEvent   `bson:"start_event" json:"start_event"`
+	StartDate    time.Time            `bson:"start_date" json:"start_date"`
+	TermDays     int                  `bson:"term_days" json:"term_days"`
+	CountingMode DeadlineCountingMode `bson:"counting_mode" json:"counting_mode"`
+	Doubled      bool                 `bson:"doubled" json:"doubled"`
+	State        string               `bson:"state,omitempty" json:"state,omitempty"`
+	Court        string               `bson:"court,omitempty" json:"court,omitempty"`
+}
+
+// NonWorkingDay é um dia desconsiderado na contagem, com o motivo
+type NonWorkingDay struct {
+	Date   time.Time `bson:"date" json:"date"`
+	Reason string    `bson:"reason" json:"reason"`
+}
+
+// DeadlineResult é o resultado do cálculo: a data de referência (intimação
+// ou publicação considerada), o primeiro dia da contagem e o vencimento
+type DeadlineResult struct {
+	ReferenceDate  time.Time       `bson:"reference_date" json:"reference_date"`
+	StartsOn       time.Time       `bson:"starts_on" json:"starts_on"`
+	DueDate        time.Time       `bson:"due_date" json:"due_date"`
+	NonWorkingDays []NonWorkingDay `bson:"non_working_days" json:"non_working_days"`
+}
+
+// Deadline é um prazo processual vinculado a um processo
+type Deadline struct {
+	ID                  primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	CaseID              primitive.ObjectID `bson:"case_id" json:"case_id"`
+	Title               string             `bson:"title" json:"title"`
+	Description         string             `bson:"description" json:"description"`
+	DeadlineCalculation `bson:",inline"`
+	DeadlineResult      `bson:",inline"`
+	Status              DeadlineStatus     `bson:"status" json:"status"`
+	CompletedAt         *time.Time         `bson:"completed_at,omitempty" json:"completed_at,omitempty"`
+	AssignedTo          primitive.ObjectID `bson:"assigned_to" json:"assigned_to"`
+	CreatedBy           primitive.ObjectID `bson:"created_by" json:"created_by"`
+	CreatedAt           time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt           time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+type DeadlineRepository interface {
+	Create(deadline *Deadline) error
+	FindByID(id string) (*Deadline, error)
+	FindByCaseID(caseID string, status DeadlineStatus) ([]*Deadline, error)
+	// FindByAssignee lista os prazos do responsável com vencimento a partir de from
+	FindByAssignee(userID string, from time.Time) ([]*Deadline, error)
+	Update(deadline *Deadline) error
+	Delete(id string) error
+}
+
+type DeadlineService interface {
+	Calculate(params DeadlineCalculation) (*DeadlineResult, error)
+	Create(deadline *Deadline) error
+	GetByID(caseID, id string) (*Deadline, error)
+	GetByCaseID(caseID string, status DeadlineStatus) ([]*Deadline, error)
+	Update(deadline *Deadline) error
+	Delete(caseID, id string) error
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"io"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -11,10 +12,43 @@ type Document struct {
 	Title       string             `bson:"title" json:"title"`
 	Description string             `bson:"description" json:"description"`
 	URL         string             `bson:"url" json:"url"`
+	FileName    string             `bson:"file_name" json:"file_name"`
+	StorageKey  string             `bson:"storage_key" json:"-"`
+	Size        int64              `bson:"size" json:"size"`
+	MimeType    string             `bson:"mime_type" json:"mime_type"`
+	SHA256      string             `bson:"sha256" json:"sha256"`
+	Version     int                `bson:"version" json:"version"`
 	CaseID      primitive.ObjectID `bson:"case_id" json:"case_id"`
 	CreatedBy   primitive.ObjectID `bson:"created_by" json:"created_by"`
 	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
 	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+	DeletedAt   *time.Time         `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy   primitive.ObjectID `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
+}
+
+// DocumentVersion é uma versão imutável do arquivo de um documento. Cada
+// versão mantém seu próprio arquivo e hash; restaurar uma versão antiga cria
+// uma nova versão apontando para o mesmo arquivo.
+type DocumentVersion struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	DocumentID   primitive.ObjectID `bson:"document_id" json:"document_id"`
+	Version      int                `bson:"version" json:"version"`
+	FileName     string             `bson:"file_name" json:"file_name"`
+	StorageKey   string             `bson:"storage_key" json:"-"`
+	Size         int64              `bson:"size" json:"size"`
+	MimeType     string             `bson:"mime_type" json:"mime_type"`
+	SHA256       string             `bson:"sha256" json:"sha256"`
+	Comment      string             `bson:"comment,omitempty" json:"comment,omitempty"`
+	RestoredFrom int                `bson:"restored_from,omitempty" json:"restored_from,omitempty"`
+	CreatedBy    primitive.ObjectID `bson:"created_by" json:"created_by"`
+	CreatedAt    time.Time          `bson:"created_at" json:"created_at"`
+}
+
+// FileUpload descreve um arquivo recebido para armazenamento
+type FileUpload struct {
+	FileName string
+	Size     int64
+	Content  io.Reader
 }
 
 type DocumentRepository interface {
@@ -22,13 +56,31 @@ type DocumentRepository interface {
 	FindByID(id string) (*Document, error)
 	FindByCaseID(caseID string) ([]*Document, error)
 	Update(document *Document) error
-	Delete(id string) error
+	Delete(id string, deletedBy primitive.ObjectID) error
+	Restore(id string) error
+	FindDeleted(deletedBefore time.Time) ([]*Document, error)
+	FindDeletedByID(id string) (*Document, error)
+	Purge(id string) error
+}
+
+type DocumentVersionRepository interface {
+	Create(version *DocumentVersion) error
+	FindByDocumentID(documentID string) ([]*DocumentVersion, error)
+	FindVersion(documentID string, version int) (*DocumentVersion, error)
+	DeleteByDocumentID(documentID string) error
 }
 
 type DocumentService interface {
 	Create(document *Document) error
+	Upload(document *Document, file FileUpload) error
+	Open(id string) (*Document, io.ReadCloser, error)
 	GetByID(id string) (*Document, error)
 	GetByCaseID(caseID string) ([]*Document, error)
 	Update(document *Document) error
-	Delete(id string) error
+	Delete(id string, deletedBy primitive.ObjectID) error
+	Purge(id string) error
+	AddVersion(id string, file FileUpload, comment string, createdBy primitive.ObjectID) (*Document, error)
+	GetVersions(id string) ([]*DocumentVersion, error)
+	OpenVersion(id string, version int) (*DocumentVersion, io.ReadCloser, error)
+	RestoreVersion(id string, version int, createdBy primitive.ObjectID) (*Document, error)
 }
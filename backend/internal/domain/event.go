@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// EventType é o tipo de compromisso da agenda
+type EventType string
+
+const (
+	EventTypeHearing   EventType = "audiencia"
+	EventTypeMeeting   EventType = "reuniao"
+	EventTypeDiligence EventType = "diligencia"
+)
+
+// IsValid informa se o tipo de compromisso é conhecido
+func (t EventType) IsValid() bool {
+	switch t {
+	case EventTypeHearing, EventTypeMeeting, EventTypeDiligence:
+		return true
+	}
+	return false
+}
+
+type EventStatus string
+
+const (
+	EventStatusScheduled EventStatus = "agendado"
+	EventStatusDone      EventStatus = "realizado"
+	EventStatusCancelled EventStatus = "cancelado"
+)
+
+// IsValid informa se o status do compromisso é conhecido
+func (s EventStatus) IsValid() bool {
+	switch s {
+	case EventStatusScheduled, EventStatusDone, EventStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// RecurrenceFrequency é a periodicidade de compromissos recorrentes
+type RecurrenceFrequency string
+
+const (
+	RecurrenceDaily   RecurrenceFrequency = "daily"
+	RecurrenceWeekly  RecurrenceFrequency = "weekly"
+	RecurrenceMonthly RecurrenceFrequency = "monthly"
+)
+
+// IsValid informa se a periodicidade é conhecida
+func (f RecurrenceFrequency) IsValid() bool {
+	switch f {
+	case RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly:
+		return true
+	}
+	return false
+}
+
+// EventRecurrence repete o compromisso a cada Interval períodos até Until
+// (inclusive) ou por Count ocorrências
+type EventRecurrence struct {
+	Frequency RecurrenceFrequency `bson:"frequency" json:"frequency"`
+	Interval  int                 `bson:"interval" json:"interval"`
+	Until     time.Time           `bson:"until,omitempty" json:"until,omitempty"`
+	Count     int                 `bson:"count,omitempty" json:"count,omitempty"`
+}
+
+// Event é um compromisso (audiência, reunião, diligência). LawyerID é o
+// responsável; Participants são os demais usuários do escritório envolvidos.
+// Ocorrências de um compromisso recorrente compartilham o SeriesID.
+type Event struct {
+	ID           primitive.ObjectID   `bson:"_id,omitempty" json:"id"`
+	Type         EventType            `bson:"type" json:"type"`
+	Title        string               `bson:"title" json:"title"`
+	Description  string               `bson:"description" json:"description"`
+	Location     string               `bson:"location" json:"location"`
+	StartAt      time.Time            `bson:"start_at" json:"start_at"`
+	EndAt        time.Time            `bson:"end_at" json:"end_at"`
+	CaseID       primitive.ObjectID   `bson:"case_id,omitempty" json:"case_id,omitempty"`
+	LawyerID     primitive.ObjectID   `bson:"lawyer_id" json:"lawyer_id"`
+	Participants []primitive.ObjectID `bson:"participants" json:"participants"`
+	Status       EventStatus          `bson:"status" json:"status"`
+	SeriesID     primitive.ObjectID   `bson:"series_id,omitempty" json:"series_id,omitempty"`
+	Recurrence   *EventRecurrence     `bson:"recurrence,omitempty" json:"recurrence,omitempty"`
+	CreatedBy    primitive.ObjectID   `bson:"created_by" json:"created_by"`
+	CreatedAt    time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time            `bson:"updated_at" json:"updated_at"`
+}
+
+// Users retorna o responsável e os participantes do compromisso
+func (e *Event) Users() []primitive.ObjectID {
+	users := []primitive.ObjectID{e.LawyerID}
+	for _, participant := range e.Participants {
+		if participant != e.LawyerID {
+			users = append(users, participant)
+		}
+	}
+	return users
+}
+
+// EventQuery filtra a agenda por período e, opcionalmente, por usuário,
+// processo, tipo e status
+type EventQuery struct {
+	From   time.Time
+	To     time.Time
+	UserID string
+	CaseID string
+	Type   EventType
+	Status EventStatus
+}
+
+type EventRepository interface {
+	Create(events ...*Event) error
+	FindByID(id string) (*Event, error)
+	Find(query EventQuery) ([]*Event, error)
+	// FindOverlapping retorna compromissos não cancelados de algum dos usuários que se sobrepõem ao intervalo
+	FindOverlapping(userIDs []primitive.ObjectID, start, end time.Time, excludeIDs []primitive.ObjectID) ([]*Event, error)
+	Update(event *Event) error
+	Delete(id string) error
+	DeleteSeries(seriesID string, from time.Time) (int64, error)
+}
+
+type EventService interface {
+	Create(event *Event, allowOverlap bool) ([]*Event, error)
+	GetByID(id string) (*Event, error)
+	List(query EventQuery) ([]*Event, error)
+	Update(event *Event, allowOverlap bool) error
+	Delete(id string, wholeSeries bool) (int64, error)
+}
@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ExpenseCategory classifica a despesa adiantada pelo escritório
+type ExpenseCategory string
+
+const (
+	ExpenseCategoryCourtFees ExpenseCategory = "custas"
+	ExpenseCategoryTravel    ExpenseCategory = "deslocamento"
+	ExpenseCategoryCopies    ExpenseCategory = "copias"
+	ExpenseCategoryPostage   ExpenseCategory = "correios"
+	ExpenseCategoryNotary    ExpenseCategory = "cartorio"
+	ExpenseCategoryExpert    ExpenseCategory = "pericia"
+	ExpenseCategoryOther     ExpenseCategory = "outro"
+)
+
+// IsValid informa se a categoria da despesa é conhecida
+func (c ExpenseCategory) IsValid() bool {
+	switch c {
+	case ExpenseCategoryCourtFees, ExpenseCategoryTravel, ExpenseCategoryCopies, ExpenseCategoryPostage,
+		ExpenseCategoryNotary, ExpenseCategoryExpert, ExpenseCategoryOther:
+		return true
+	}
+	return false
+}
+
+type ExpenseStatus string
+
+const (
+	ExpenseStatusPending  ExpenseStatus = "pendente"
+	ExpenseStatusApproved ExpenseStatus = "aprovada"
+	ExpenseStatusRejected ExpenseStatus = "rejeitada"
+)
+
+// IsValid informa se o status da despesa é conhecido
+func (s ExpenseStatus) IsValid() bool {
+	return s == ExpenseStatusPending || s == ExpenseStatusApproved || s == ExpenseStatusRejected
+}
+
+// Expense é uma despesa de um processo paga pelo escritório. Depois de
+// aprovada pelo supervisor de quem a lançou, entra na próxima fatura do
+// cliente quando BillToClient está marcado. O comprovante é um documento do
+// processo. Valores monetários são em centavos.
+type Expense struct {
+	ID                primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	CaseID            primitive.ObjectID `bson:"case_id" json:"case_id"`
+	ClientID          primitive.ObjectID `bson:"client_id" json:"client_id"`
+	Category          ExpenseCategory    `bson:"category" json:"category"`
+	Description       string             `bson:"description" json:"description"`
+	Date              time.Time          `bson:"date" json:"date"`
+	AmountCents       int64              `bson:"amount_cents" json:"amount_cents"`
+	BillToClient      bool               `bson:"bill_to_client" json:"bill_to_client"`
+	ReceiptDocumentID primitive.ObjectID `bson:"receipt_document_id,omitempty" json:"receipt_document_id,omitempty"`
+	Status            ExpenseStatus      `bson:"status" json:"status"`
+	ApproverID        primitive.ObjectID `bson:"approver_id,omitempty" json:"approver_id,omitempty"`
+	ReviewedBy        primitive.ObjectID `bson:"reviewed_by,omitempty" json:"reviewed_by,omitempty"`
+	ReviewedAt        *time.Time         `bson:"reviewed_at,omitempty" json:"reviewed_at,omitempty"`
+	RejectionReason   string             `bson:"rejection_reason,omitempty" json:"rejection_reason,omitempty"`
+	InvoiceID         primitive.ObjectID `bson:"invoice_id,omitempty" json:"invoice_id,omitempty"`
+	CreatedBy         primitive.ObjectID `bson:"created_by" json:"created_by"`
+	CreatedAt         time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt         time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// ExpenseQuery filtra despesas; Unbilled seleciona as aprovadas, a cobrar do
+// cliente e ainda sem fatura, com data no intervalo [From, To)
+type ExpenseQuery struct {
+	CaseID     string
+	ClientID   string
+	CreatedBy  string
+	ApproverID string
+	Status     ExpenseStatus
+	From       time.Time
+	To         time.Time
+	Unbilled   bool
+}
+
+type ExpenseRepository interface {
+	Create(expense *Expense) error
+	FindByID(id string) (*Expense, error)
+	Find(query ExpenseQuery) ([]*Expense, error)
+	Update(expense *Expense) error
+	Delete(id string) error
+	// SetInvoice vincula as despesas à fatura que as cobrou; falha se alguma
+	// delas já estiver em outra fatura, mantendo os vínculos feitos até então
+	SetInvoice(ids []primitive.ObjectID, invoiceID primitive.ObjectID) error
+	// ReleaseInvoice desvincula as despesas da fatura cancelada ou removida
+	ReleaseInvoice(invoiceID primitive.ObjectID) error
+}
+
+type ExpenseService interface {
+	Create(expense *Expense) error
+	GetByID(id string) (*Expense, error)
+	List(query ExpenseQuery) ([]*Expense, error)
+	GetPendingApproval(approver *User) ([]*Expense, error)
+	Update(expense *Expense, editedBy primitive.ObjectID) error
+	Delete(id string, deletedBy *User) error
+	AttachReceipt(id string, file FileUpload, uploadedBy primitive.ObjectID) (*Expense, error)
+	Approve(id string, reviewer *User) (*Expense, error)
+	Reject(id string, reviewer *User, reason string) (*Expense, error)
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FeeType é a modalidade de cobrança de honorários
+type FeeType string
+
+const (
+	FeeTypeFixed    FeeType = "fixo"
+	FeeTypeHourly   FeeType = "hora"
+	FeeTypeSuccess  FeeType = "exito"
+	FeeTypeRetainer FeeType = "mensalidade"
+)
+
+// IsValid informa se a modalidade de honorários é conhecida
+func (t FeeType) IsValid() bool {
+	switch t {
+	case FeeTypeFixed, FeeTypeHourly, FeeTypeSuccess, FeeTypeRetainer:
+		return true
+	}
+	return false
+}
+
+// PeriodLayout é o formato das competências mensais (AAAA-MM)
+const PeriodLayout = "2006-01"
+
+// FeeAgreement é o contrato de honorários com o cliente, valendo para um
+// processo específico ou, sem CaseID, para todos os processos do cliente.
+// Valores monetários são em centavos.
+//
+//   - fixo: AmountCents cobrado uma única vez
+//   - hora: HourlyRateCents aplicado aos lançamentos de horas
+//   - exito: SuccessPercentage sobre SuccessBaseCents (proveito econômico),
+//     cobrado quando a base é informada
+//   - mensalidade: AmountCents por competência mensal entre StartDate e EndDate
+type FeeAgreement struct {
+	ID                primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	ClientID          primitive.ObjectID `bson:"client_id" json:"client_id"`
+	CaseID            primitive.ObjectID `bson:"case_id,omitempty" json:"case_id,omitempty"`
+	Type              FeeType            `bson:"type" json:"type"`
+	Description       string             `bson:"description" json:"description"`
+	AmountCents       int64              `bson:"amount_cents,omitempty" json:"amount_cents,omitempty"`
+	HourlyRateCents   int64              `bson:"hourly_rate_cents,omitempty" json:"hourly_rate_cents,omitempty"`
+	SuccessPercentage float64            `bson:"success_percentage,omitempty" json:"success_percentage,omitempty"`
+	SuccessBaseCents  int64              `bson:"success_base_cents,omitempty" json:"success_base_cents,omitempty"`
+	StartDate         time.Time          `bson:"start_date" json:"start_date"`
+	EndDate           *time.Time         `bson:"end_date,omitempty" json:"end_date,omitempty"`
+	IsActive          bool               `bson:"is_active" json:"is_active"`
+	// BilledInvoiceID é a fatura que cobrou os honorários fixos ou de êxito
+	BilledInvoiceID primitive.ObjectID `bson:"billed_invoice_id,omitempty" json:"billed_invoice_id,omitempty"`
+	// BilledPeriods são as competências de mensalidade já faturadas
+	BilledPeriods []string           `bson:"billed_periods" json:"billed_periods"`
+	CreatedBy     primitive.ObjectID `bson:"created_by" json:"created_by"`
+	CreatedAt     time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// AppliesTo informa se o contrato vale para o processo
+func (a *FeeAgreement) AppliesTo(caseID primitive.ObjectID) bool {
+	return a.CaseID.IsZero() || a.CaseID == caseID
+}
+
+type FeeAgreementRepository interface {
+	Create(agreement *FeeAgreement) error
+	FindByID(id string) (*FeeAgreement, error)
+	// FindByClientID lista os contratos do cliente; com activeOnly, apenas os ativos
+	FindByClientID(clientID string, activeOnly bool) ([]*FeeAgreement, error)
+	Update(agreement *FeeAgreement) error
+	// UpdateIfUnchanged grava o contrato apenas se ele não foi alterado desde
+	// previousUpdatedAt
+	UpdateIfUnchanged(agreement *FeeAgreement, previousUpdatedAt time.Time) error
+	// ReleaseInvoice desfaz atomicamente as cobranças da fatura no contrato:
+	// as competências informadas e o vínculo BilledInvoiceID
+	ReleaseInvoice(id primitive.ObjectID, invoiceID primitive.ObjectID, periods []string) error
+	Delete(id string) error
+}
+
+type FeeAgreementService interface {
+	Create(agreement *FeeAgreement) error
+	GetByID(id string) (*FeeAgreement, error)
+	GetByClientID(clientID string) ([]*FeeAgreement, error)
+	Update(agreement *FeeAgreement) error
+	Delete(id string) error
+}
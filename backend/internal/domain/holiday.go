@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// HolidayScope define a abrangência de um feriado
+type HolidayScope string
+
+const (
+	HolidayScopeNational HolidayScope = "national"
+	HolidayScopeState    HolidayScope = "state"
+	HolidayScopeCourt    HolidayScope = "court"
+)
+
+// IsValid informa se a abrangência é conhecida
+func (s HolidayScope) IsValid() bool {
+	switch s {
+	case HolidayScopeNational, HolidayScopeState, HolidayScopeCourt:
+		return true
+	}
+	return false
+}
+
+// HolidayKind distingue feriados de suspensões de expediente (ex.: falha no
+// sistema, correição, ponto facultativo)
+type HolidayKind string
+
+const (
+	HolidayKindHoliday    HolidayKind = "feriado"
+	HolidayKindSuspension HolidayKind = "suspensao"
+)
+
+// IsValid informa se o tipo é conhecido
+func (k HolidayKind) IsValid() bool {
+	return k == HolidayKindHoliday || k == HolidayKindSuspension
+}
+
+// Holiday é um dia (ou período, de Date a EndDate inclusive) sem expediente
+// forense. State é a UF (como em Address.State) e Court o código do órgão no
+// formato do número CNJ ("8.26" para o TJSP inteiro, "8.26.0100" para um
+// foro específico). Feriados recorrentes se repetem todo ano no mesmo dia e mês.
+type Holiday struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Name      string             `bson:"name" json:"name"`
+	Kind      HolidayKind        `bson:"kind" json:"kind"`
+	Date      time.Time          `bson:"date" json:"date"`
+	EndDate   time.Time          `bson:"end_date" json:"end_date"`
+	Scope     HolidayScope       `bson:"scope" json:"scope"`
+	State     string             `bson:"state,omitempty" json:"state,omitempty"`
+	Court     string             `bson:"court,omitempty" json:"court,omitempty"`
+	Recurring bool               `bson:"recurring" json:"recurring"`
+	CreatedBy primitive.ObjectID `bson:"created_by,omitempty" json:"created_by,omitempty"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// AppliesTo informa se o feriado vale para a UF e o órgão informados
+func (h *Holiday) AppliesTo(state, court string) bool {
+	switch h.Scope {
+	case HolidayScopeNational:
+		return true
+	case HolidayScopeState:
+		return state != "" && h.State == state
+	case HolidayScopeCourt:
+		return court != "" && (court == h.Court || strings.HasPrefix(court, h.Court+"."))
+	}
+	return false
+}
+
+// HolidayQuery filtra o cadastro de feriados; campos vazios são ignorados
+type HolidayQuery struct {
+	Year  int
+	Scope HolidayScope
+	State string
+	Court string
+}
+
+// HolidayImportResult resume a importação de um arquivo de feriados
+type HolidayImportResult struct {
+	Created int      `json:"created"`
+	Skipped int      `json:"skipped"`
+	Errors  []string `json:"errors"`
+}
+
+// WorkingDayInfo responde se uma data é dia útil para um juízo
+type WorkingDayInfo struct {
+	Date           time.Time `json:"date"`
+	State          string    `json:"state,omitempty"`
+	Court          string    `json:"court,omitempty"`
+	WorkingDay     bool      `json:"working_day"`
+	Reason         string    `json:"reason,omitempty"`
+	NextWorkingDay time.Time `json:"next_working_day"`
+}
+
+type HolidayRepository interface {
+	Create(holiday *Holiday) error
+	FindByID(id string) (*Holiday, error)
+	Find(query HolidayQuery) ([]*Holiday, error)
+	// FindBetween retorna os feriados que alcançam o período e todos os recorrentes
+	FindBetween(from, to time.Time) ([]*Holiday, error)
+	Update(holiday *Holiday) error
+	Delete(id string) error
+}
+
+type HolidayService interface {
+	Create(holiday *Holiday) error
+	GetByID(id string) (*Holiday, error)
+	List(query HolidayQuery) ([]*Holiday, error)
+	Update(holiday *Holiday) error
+	Delete(id string) error
+	Import(format string, content io.Reader, defaults Holiday) (*HolidayImportResult, error)
+	WorkingDay(date time.Time, state, court string) (*WorkingDayInfo, error)
+	NonWorkingDays(from, to time.Time, state, court string) ([]NonWorkingDay, error)
+}
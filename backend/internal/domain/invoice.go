@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type InvoiceStatus string
+
+const (
+	InvoiceStatusDraft     InvoiceStatus = "rascunho"
+	InvoiceStatusIssued    InvoiceStatus = "emitida"
+	InvoiceStatusPaid      InvoiceStatus = "paga"
+	InvoiceStatusOverdue   InvoiceStatus = "vencida"
+	InvoiceStatusCancelled InvoiceStatus = "cancelada"
+)
+
+// IsValid informa se o status da fatura é conhecido
+func (s InvoiceStatus) IsValid() bool {
+	switch s {
+	case InvoiceStatusDraft, InvoiceStatusIssued, InvoiceStatusPaid, InvoiceStatusOverdue, InvoiceStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// IsReceivable informa se a fatura emitida ainda aguarda pagamento
+func (s InvoiceStatus) IsReceivable() bool {
+	return s == InvoiceStatusIssued || s == InvoiceStatusOverdue
+}
+
+// InvoiceItemKind identifica a origem do item da fatura
+type InvoiceItemKind string
+
+const (
+	InvoiceItemFixedFee   InvoiceItemKind = "honorario_fixo"
+	InvoiceItemTime       InvoiceItemKind = "hora"
+	InvoiceItemSuccessFee InvoiceItemKind = "exito"
+	InvoiceItemRetainer   InvoiceItemKind = "mensalidade"
+	InvoiceItemExpense    InvoiceItemKind = "despesa"
+)
+
+// InvoiceItem é uma linha da fatura. SourceID aponta para o lançamento de
+// horas, a despesa ou o contrato de honorários que originou o item.
+type InvoiceItem struct {
+	Kind        InvoiceItemKind    `bson:"kind" json:"kind"`
+	Description string             `bson:"description" json:"description"`
+	SourceID    primitive.ObjectID `bson:"source_id" json:"source_id"`
+	CaseID      primitive.ObjectID `bson:"case_id,omitempty" json:"case_id,omitempty"`
+	Period      string             `bson:"period,omitempty" json:"period,omitempty"`
+	Date        time.Time          `bson:"date" json:"date"`
+	Minutes     int                `bson:"minutes,omitempty" json:"minutes,omitempty"`
+	UnitCents   int64              `bson:"unit_cents,omitempty" json:"unit_cents,omitempty"`
+	AmountCents int64              `bson:"amount_cents" json:"amount_cents"`
+}
+
+// Invoice é a fatura de honorários e despesas de um cliente. O número
+// sequencial e o txid da cobrança Pix são atribuídos na emissão. Valores
+// monetários são em centavos.
+type Invoice struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Number          string             `bson:"number,omitempty" json:"number,omitempty"`
+	ClientID        primitive.ObjectID `bson:"client_id" json:"client_id"`
+	CaseID          primitive.ObjectID `bson:"case_id,omitempty" json:"case_id,omitempty"`
+	Status          InvoiceStatus      `bson:"status" json:"status"`
+	Items           []InvoiceItem      `bson:"items" json:"items"`
+	TotalCents      int64              `bson:"total_cents" json:"total_cents"`
+	PeriodStart     *time.Time         `bson:"period_start,omitempty" json:"period_start,omitempty"`
+	PeriodEnd       time.Time          `bson:"period_end" json:"period_end"`
+	IssueDate       *time.Time         `bson:"issue_date,omitempty" json:"issue_date,omitempty"`
+	DueDate         time.Time          `bson:"due_date" json:"due_date"`
+	PixTxID         string             `bson:"pix_txid,omitempty" json:"pix_txid,omitempty"`
+	PaidAt          *time.Time         `bson:"paid_at,omitempty" json:"paid_at,omitempty"`
+	PaidAmountCents int64              `bson:"paid_amount_cents,omitempty" json:"paid_amount_cents,omitempty"`
+	CancelledAt     *time.Time         `bson:"cancelled_at,omitempty" json:"cancelled_at,omitempty"`
+	CancelReason    string             `bson:"cancel_reason,omitempty" json:"cancel_reason,omitempty"`
+	Notes           string             `bson:"notes" json:"notes"`
+	CreatedBy       primitive.ObjectID `bson:"created_by" json:"created_by"`
+	CreatedAt       time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt       time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// InvoiceGeneration define o que entra em uma nova fatura: itens não
+// faturados do cliente (ou apenas do processo) até PeriodEnd
+type InvoiceGeneration struct {
+	ClientID    primitive.ObjectID
+	CaseID      primitive.ObjectID
+	PeriodStart *time.Time
+	PeriodEnd   time.Time
+	DueDate     time.Time
+	Notes       string
+	CreatedBy   primitive.ObjectID
+}
+
+type InvoiceQuery struct {
+	ClientID string
+	CaseID   string
+	Status   InvoiceStatus
+}
+
+// StatementEntry é um lançamento do extrato: fatura emitida (débito) ou pagamento (crédito)
+type StatementEntry struct {
+	Date         time.Time          `json:"date"`
+	InvoiceID    primitive.ObjectID `json:"invoice_id"`
+	Number       string             `json:"number"`
+	Description  string             `json:"description"`
+	DebitCents   int64              `json:"debit_cents"`
+	CreditCents  int64              `json:"credit_cents"`
+	BalanceCents int64              `json:"balance_cents"`
+}
+
+// ClientStatement é o extrato de conta do cliente no período
+type ClientStatement struct {
+	ClientID            primitive.ObjectID `json:"client_id"`
+	From                time.Time          `json:"from"`
+	To                  time.Time          `json:"to"`
+	OpeningBalanceCents int64              `json:"opening_balance_cents"`
+	Entries             []StatementEntry   `json:"entries"`
+	ClosingBalanceCents int64              `json:"closing_balance_cents"`
+	OverdueCents        int64              `json:"overdue_cents"`
+}
+
+// MonthlyBilling totaliza o faturamento de uma competência
+type MonthlyBilling struct {
+	Period        string `json:"period"`
+	IssuedCents   int64  `json:"issued_cents"`
+	ReceivedCents int64  `json:"received_cents"`
+}
+
+type InvoiceRepository interface {
+	Create(invoice *Invoice) error
+	FindByID(id string) (*Invoice, error)
+	FindByPixTxID(txid string) (*Invoice, error)
+	Find(query InvoiceQuery) ([]*Invoice, error)
+	// FindByClientIssuedBefore lista as faturas emitidas do cliente até a data, exceto rascunhos e canceladas
+	FindByClientIssuedBefore(clientID string, to time.Time) ([]*Invoice, error)
+	// FindIssuedOrPaidBetween lista faturas emitidas ou pagas no intervalo [from, to)
+	FindIssuedOrPaidBetween(from, to time.Time) ([]*Invoice, error)
+	Update(invoice *Invoice) error
+	// SetPixTxID grava o txid de uma fatura que ainda não tem um
+	SetPixTxID(id primitive.ObjectID, txid string) error
+	Delete(id string) error
+	// MarkOverdue passa a vencidas as faturas emitidas com vencimento anterior à data
+	MarkOverdue(before time.Time) error
+	// NextNumber reserva o próximo número sequencial de fatura do ano
+	NextNumber(year int) (string, error)
+}
+
+type InvoiceService interface {
+	Generate(params InvoiceGeneration) (*Invoice, error)
+	GetByID(id string) (*Invoice, error)
+	List(query InvoiceQuery) ([]*Invoice, error)
+	Update(invoice *Invoice) error
+	Issue(id string) (*Invoice, error)
+	MarkPaid(id string, paidAt time.Time, amountCents int64) (*Invoice, error)
+	Cancel(id, reason string) (*Invoice, error)
+	Delete(id string) error
+	Statement(clientID string, from, to time.Time) (*ClientStatement, error)
+	MonthlySummary(year int) ([]MonthlyBilling, error)
+	MarkOverdue() error
+}
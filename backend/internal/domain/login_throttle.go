@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// LoginThrottleScope indica o que é limitado: a conta (email) ou o IP de
+// origem, no login ou nos pedidos de redefinição de senha
+type LoginThrottleScope string
+
+const (
+	LoginThrottleAccount         LoginThrottleScope = "account"
+	LoginThrottleIP              LoginThrottleScope = "ip"
+	PasswordResetThrottleAccount LoginThrottleScope = "reset_account"
+	PasswordResetThrottleIP      LoginThrottleScope = "reset_ip"
+)
+
+// LoginThrottle guarda as falhas de login de uma conta ou IP. Failures conta
+// as falhas desde WindowStart; Lockouts conta os bloqueios já aplicados e
+// determina a duração do próximo.
+type LoginThrottle struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Scope         LoginThrottleScope `bson:"scope" json:"scope"`
+	Subject       string             `bson:"subject" json:"subject"`
+	Failures      int                `bson:"failures" json:"failures"`
+	WindowStart   time.Time          `bson:"window_start" json:"window_start"`
+	Lockouts      int                `bson:"lockouts" json:"lockouts"`
+	LockedUntil   *time.Time         `bson:"locked_until,omitempty" json:"locked_until,omitempty"`
+	LastFailureAt time.Time          `bson:"last_failure_at" json:"last_failure_at"`
+	// ExpiresAt é quando o registro é descartado, zerando o histórico
+	ExpiresAt time.Time `bson:"expires_at" json:"-"`
+}
+
+func (t *LoginThrottle) IsLocked(now time.Time) bool {
+	return t.LockedUntil != nil && now.Before(*t.LockedUntil)
+}
+
+// LoginThrottleRepository guarda o estado do limitador de login fora do
+// processo, para valer em todas as instâncias do backend. As operações de
+// falha e bloqueio precisam ser atômicas no armazenamento.
+type LoginThrottleRepository interface {
+	Find(scope LoginThrottleScope, subject string) (*LoginThrottle, error)
+	// RecordFailure soma uma falha, reiniciando a contagem se a janela
+	// iniciada em WindowStart já tiver passado, e retorna o estado atualizado
+	RecordFailure(scope LoginThrottleScope, subject string, now time.Time, window time.Duration, expiresAt time.Time) (*LoginThrottle, error)
+	Lock(id primitive.ObjectID, until time.Time, expiresAt time.Time) error
+	FindLocked(now time.Time) ([]*LoginThrottle, error)
+	// ResetFailures zera as falhas e o bloqueio vigente, mantendo Lockouts
+	ResetFailures(scope LoginThrottleScope, subject string) error
+	Reset(scope LoginThrottleScope, subject string) error
+	Delete(id string) error
+}
+
+type LoginThrottleService interface {
+	Check(email, ip string) error
+	RecordFailure(email, ip string) error
+	RecordSuccess(email string) error
+	RecordRequest(scope LoginThrottleScope, subject string, limit int, window time.Duration) (bool, error)
+	GetLocked() ([]*LoginThrottle, error)
+	UnlockUser(userID string) error
+	Unlock(id string) error
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PasswordResetToken é um pedido de redefinição de senha ("esqueci minha
+// senha"). Apenas o hash do token é armazenado; o token em si vai por e-mail
+// e só pode ser usado uma vez, antes de ExpiresAt.
+type PasswordResetToken struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID    primitive.ObjectID `bson:"user_id" json:"user_id"`
+	TokenHash string             `bson:"token_hash" json:"-"`
+	RequestIP string             `bson:"request_ip,omitempty" json:"request_ip,omitempty"`
+	ExpiresAt time.Time          `bson:"expires_at" json:"expires_at"`
+	UsedAt    *time.Time         `bson:"used_at,omitempty" json:"used_at,omitempty"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+}
+
+type PasswordResetTokenRepository interface {
+	Create(token *PasswordResetToken) error
+	FindByHash(tokenHash string) (*PasswordResetToken, error)
+	// MarkUsed consome o token; falha se outro pedido já o tiver usado
+	MarkUsed(id primitive.ObjectID) error
+	DeleteByUser(userID string) error
+}
+
+type PasswordService interface {
+	RequestReset(email, ip string) error
+	ResetPassword(rawToken, newPassword string) error
+	ChangePassword(user *User, currentPassword, newPassword string) error
+	ResetByAdmin(id string) (string, error)
+}
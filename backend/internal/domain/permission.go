@@ -1,14 +1,56 @@
 package domain
 
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Módulos do sistema sujeitos a controle de acesso
+const (
+	ModuleUsers     = "users"
+	ModuleCases     = "cases"
+	ModuleDocuments = "documents"
+	ModuleReports   = "reports"
+	ModuleRoles     = "roles"
+	ModuleClients   = "clients"
+	ModuleDeadlines = "deadlines"
+	ModuleCalendar  = "calendar"
+	ModuleEvents    = "events"
+	ModuleTasks     = "tasks"
+	ModuleTimesheet = "timesheet"
+	ModuleBilling   = "billing"
+	ModuleExpenses  = "expenses"
+	ModuleTrash     = "trash"
+)
+
+// Modules lista os módulos aceitos nas permissões das roles
+var Modules = []string{ModuleUsers, ModuleCases, ModuleDocuments, ModuleReports, ModuleRoles, ModuleClients, ModuleDeadlines, ModuleCalendar, ModuleEvents, ModuleTasks, ModuleTimesheet, ModuleBilling, ModuleExpenses, ModuleTrash}
+
+// Ações possíveis sobre um módulo
+const (
+	ActionCreate = "create"
+	ActionRead   = "read"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Actions lista as ações aceitas nas permissões das roles
+var Actions = []string{ActionCreate, ActionRead, ActionUpdate, ActionDelete}
+
 type Permission struct {
 	Module  string   `bson:"module" json:"module"`
 	Actions []string `bson:"actions" json:"actions"`
 }
 
 type Role struct {
-	Name        string       `bson:"name" json:"name"`
-	Description string       `bson:"description" json:"description"`
-	Permissions []Permission `bson:"permissions" json:"permissions"`
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Name        string             `bson:"name" json:"name"`
+	Description string             `bson:"description" json:"description"`
+	Permissions []Permission       `bson:"permissions" json:"permissions"`
+	IsSystem    bool               `bson:"is_system" json:"is_system"`
+	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
 }
 
 // Roles predefinidas
@@ -21,7 +63,18 @@ var (
 			{Module: "cases", Actions: []string{"create", "read", "update", "delete"}},
 			{Module: "documents", Actions: []string{"create", "read", "update", "delete"}},
 			{Module: "reports", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "roles", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "clients", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "deadlines", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "calendar", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "events", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "tasks", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "timesheet", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "expenses", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "billing", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "trash", Actions: []string{"read", "update", "delete"}},
 		},
+		IsSystem: true,
 	}
 
 	RoleLawyer = Role{
@@ -30,7 +83,16 @@ var (
 		Permissions: []Permission{
 			{Module: "cases", Actions: []string{"create", "read", "update"}},
 			{Module: "documents", Actions: []string{"create", "read", "update"}},
+			{Module: "clients", Actions: []string{"create", "read", "update"}},
+			{Module: "deadlines", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "calendar", Actions: []string{"read"}},
+			{Module: "events", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "tasks", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "timesheet", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "expenses", Actions: []string{"create", "read", "update", "delete"}},
+			{Module: "billing", Actions: []string{"create", "read", "update"}},
 		},
+		IsSystem: true,
 	}
 
 	RoleIntern = Role{
@@ -39,7 +101,15 @@ var (
 		Permissions: []Permission{
 			{Module: "cases", Actions: []string{"read"}},
 			{Module: "documents", Actions: []string{"create", "read"}},
+			{Module: "clients", Actions: []string{"read"}},
+			{Module: "deadlines", Actions: []string{"create", "read"}},
+			{Module: "calendar", Actions: []string{"read"}},
+			{Module: "events", Actions: []string{"read"}},
+			{Module: "tasks", Actions: []string{"create", "read", "update"}},
+			{Module: "timesheet", Actions: []string{"create", "read", "update"}},
+			{Module: "expenses", Actions: []string{"create", "read", "update"}},
 		},
+		IsSystem: true,
 	}
 
 	RoleSecretary = Role{
@@ -48,6 +118,50 @@ var (
 		Permissions: []Permission{
 			{Module: "cases", Actions: []string{"read"}},
 			{Module: "documents", Actions: []string{"read"}},
+			{Module: "clients", Actions: []string{"create", "read", "update"}},
+			{Module: "deadlines", Actions: []string{"create", "read", "update"}},
+			{Module: "calendar", Actions: []string{"read"}},
+			{Module: "events", Actions: []string{"create", "read", "update"}},
+			{Module: "tasks", Actions: []string{"create", "read", "update"}},
+			{Module: "timesheet", Actions: []string{"read"}},
+			{Module: "expenses", Actions: []string{"create", "read", "update"}},
+			{Module: "billing", Actions: []string{"create", "read", "update"}},
 		},
+		IsSystem: true,
 	}
 )
+
+// PredefinedRoles lista as roles criadas automaticamente e que não podem ser removidas
+var PredefinedRoles = []Role{RoleAdmin, RoleLawyer, RoleIntern, RoleSecretary}
+
+type RoleRepository interface {
+	Create(role *Role) error
+	FindByName(name string) (*Role, error)
+	FindAll() ([]*Role, error)
+	Update(role *Role) error
+	Delete(name string) error
+}
+
+type RoleService interface {
+	Create(role *Role) error
+	GetByName(name string) (*Role, error)
+	List() ([]*Role, error)
+	Update(role *Role) error
+	Delete(name string) error
+	SeedDefaults() error
+}
+
+// Can informa se a role concede a ação sobre o módulo
+func (r Role) Can(module, action string) bool {
+	for _, permission := range r.Permissions {
+		if permission.Module != module {
+			continue
+		}
+		for _, allowed := range permission.Actions {
+			if allowed == action {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"io"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PixCharge é a cobrança Pix de uma fatura: o BR Code "copia e cola" e o
+// txid usado na conciliação
+type PixCharge struct {
+	InvoiceID   primitive.ObjectID `json:"invoice_id"`
+	Number      string             `json:"number"`
+	TxID        string             `json:"txid"`
+	AmountCents int64              `json:"amount_cents"`
+	DueDate     time.Time          `json:"due_date"`
+	BRCode      string             `json:"brcode"`
+}
+
+// PixMatchStatus é o resultado da conciliação de um Pix recebido
+type PixMatchStatus string
+
+const (
+	PixMatchPaid        PixMatchStatus = "baixada"
+	PixMatchAlreadyPaid PixMatchStatus = "ja_paga"
+	PixMatchNotFound    PixMatchStatus = "nao_encontrada"
+	PixMatchRejected    PixMatchStatus = "rejeitada"
+)
+
+// PixMatch é uma linha do extrato conciliada (ou não) com uma fatura
+type PixMatch struct {
+	TxID        string             `json:"txid"`
+	EndToEndID  string             `json:"end_to_end_id,omitempty"`
+	AmountCents int64              `json:"amount_cents"`
+	PaidAt      time.Time          `json:"paid_at"`
+	Status      PixMatchStatus     `json:"status"`
+	InvoiceID   primitive.ObjectID `json:"invoice_id,omitempty"`
+	Number      string             `json:"number,omitempty"`
+	Error       string             `json:"error,omitempty"`
+}
+
+// PixReconciliation resume o processamento de um extrato de Pix recebidos
+type PixReconciliation struct {
+	Total       int        `json:"total"`
+	Paid        int        `json:"paid"`
+	AlreadyPaid int        `json:"already_paid"`
+	NotFound    int        `json:"not_found"`
+	Rejected    int        `json:"rejected"`
+	Matches     []PixMatch `json:"matches"`
+}
+
+type PixService interface {
+	Charge(invoiceID string) (*PixCharge, error)
+	QRCode(invoiceID string, size int) ([]byte, error)
+	Reconcile(statement io.Reader) (*PixReconciliation, error)
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TaskPriority é a prioridade de uma tarefa
+type TaskPriority string
+
+const (
+	TaskPriorityLow    TaskPriority = "baixa"
+	TaskPriorityMedium TaskPriority = "media"
+	TaskPriorityHigh   TaskPriority = "alta"
+	TaskPriorityUrgent TaskPriority = "urgente"
+)
+
+// IsValid informa se a prioridade é conhecida
+func (p TaskPriority) IsValid() bool {
+	switch p {
+	case TaskPriorityLow, TaskPriorityMedium, TaskPriorityHigh, TaskPriorityUrgent:
+		return true
+	}
+	return false
+}
+
+type TaskStatus string
+
+const (
+	TaskStatusPending    TaskStatus = "pendente"
+	TaskStatusInProgress TaskStatus = "em_andamento"
+	TaskStatusDone       TaskStatus = "concluida"
+	TaskStatusCancelled  TaskStatus = "cancelada"
+)
+
+// IsValid informa se o status da tarefa é conhecido
+func (s TaskStatus) IsValid() bool {
+	switch s {
+	case TaskStatusPending, TaskStatusInProgress, TaskStatusDone, TaskStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// IsOpen informa se a tarefa ainda precisa ser executada
+func (s TaskStatus) IsOpen() bool {
+	return s == TaskStatusPending || s == TaskStatusInProgress
+}
+
+// OpenTaskStatuses lista os status de tarefas ainda não encerradas
+var OpenTaskStatuses = []TaskStatus{TaskStatusPending, TaskStatusInProgress}
+
+// ChecklistItem é um passo da tarefa
+type ChecklistItem struct {
+	ID     primitive.ObjectID `bson:"id" json:"id"`
+	Text   string             `bson:"text" json:"text"`
+	Done   bool               `bson:"done" json:"done"`
+	DoneBy primitive.ObjectID `bson:"done_by,omitempty" json:"done_by,omitempty"`
+	DoneAt *time.Time         `bson:"done_at,omitempty" json:"done_at,omitempty"`
+}
+
+type TaskComment struct {
+	ID        primitive.ObjectID `bson:"id" json:"id"`
+	AuthorID  primitive.ObjectID `bson:"author_id" json:"author_id"`
+	Text      string             `bson:"text" json:"text"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+}
+
+// Task é uma tarefa atribuída a um usuário, opcionalmente vinculada a um
+// processo. CreatedBy diferente de AssigneeID indica tarefa delegada.
+type Task struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Title       string             `bson:"title" json:"title"`
+	Description string             `bson:"description" json:"description"`
+	AssigneeID  primitive.ObjectID `bson:"assignee_id" json:"assignee_id"`
+	CaseID      primitive.ObjectID `bson:"case_id,omitempty" json:"case_id,omitempty"`
+	Priority    TaskPriority       `bson:"priority" json:"priority"`
+	DueDate     *time.Time         `bson:"due_date,omitempty" json:"due_date,omitempty"`
+	Status      TaskStatus         `bson:"status" json:"status"`
+	Checklist   []ChecklistItem    `bson:"checklist" json:"checklist"`
+	Comments    []TaskComment      `bson:"comments" json:"comments"`
+	CompletedAt *time.Time         `bson:"completed_at,omitempty" json:"completed_at,omitempty"`
+	CreatedBy   primitive.ObjectID `bson:"created_by" json:"created_by"`
+	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// IsOverdue informa se a tarefa aberta passou do vencimento
+func (t *Task) IsOverdue(now time.Time) bool {
+	return t.Status.IsOpen() && t.DueDate != nil && t.DueDate.Before(now)
+}
+
+// TaskQuery filtra tarefas; campos vazios não restringem a busca.
+// DueBefore seleciona tarefas abertas vencidas antes da data.
+type TaskQuery struct {
+	AssigneeIDs []primitive.ObjectID
+	CreatedBy   string
+	CaseID      string
+	Status      TaskStatus
+	Priority    TaskPriority
+	DueBefore   time.Time
+}
+
+type TaskRepository interface {
+	Create(task *Task) error
+	FindByID(id string) (*Task, error)
+	Find(query TaskQuery) ([]*Task, error)
+	Update(task *Task) error
+	AddComment(taskID string, comment TaskComment) error
+	Delete(id string) error
+}
+
+type TaskService interface {
+	Create(task *Task) error
+	GetByID(id string) (*Task, error)
+	List(query TaskQuery) ([]*Task, error)
+	GetMine(userID string, status TaskStatus) ([]*Task, error)
+	GetDelegated(supervisorID string, status TaskStatus) ([]*Task, error)
+	GetOverdue(assigneeID string) ([]*Task, error)
+	Update(task *Task, userID primitive.ObjectID) error
+	SetChecklistItem(taskID, itemID string, done *bool, text string, userID primitive.ObjectID) (*Task, error)
+	AddComment(taskID string, comment *TaskComment) error
+	Delete(id string) error
+}
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ActivityCode classifica a atividade registrada na folha de horas
+type ActivityCode string
+
+const (
+	ActivityConsultation   ActivityCode = "consulta"
+	ActivityDrafting       ActivityCode = "peticao"
+	ActivityHearing        ActivityCode = "audiencia"
+	ActivityMeeting        ActivityCode = "reuniao"
+	ActivityResearch       ActivityCode = "pesquisa"
+	ActivityDiligence      ActivityCode = "diligencia"
+	ActivityTravel         ActivityCode = "deslocamento"
+	ActivityAdministrative ActivityCode = "administrativo"
+	ActivityOther          ActivityCode = "outro"
+)
+
+// IsValid informa se o código de atividade é conhecido
+func (a ActivityCode) IsValid() bool {
+	switch a {
+	case ActivityConsultation, ActivityDrafting, ActivityHearing, ActivityMeeting, ActivityResearch,
+		ActivityDiligence, ActivityTravel, ActivityAdministrative, ActivityOther:
+		return true
+	}
+	return false
+}
+
+// RateSource indica de onde veio o valor da hora aplicado ao lançamento
+type RateSource string
+
+const (
+	RateSourceAgreement RateSource = "contrato"
+	RateSourceClient    RateSource = "cliente"
+	RateSourceUser      RateSource = "usuario"
+	RateSourceNone      RateSource = "nenhum"
+)
+
+// TimeEntry é um lançamento de horas trabalhadas em um processo. Lançamentos
+// com Running ativo são cronômetros em andamento: têm StartAt, mas ainda não
+// têm EndAt nem duração. Valores monetários são em centavos.
+type TimeEntry struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID       primitive.ObjectID `bson:"user_id" json:"user_id"`
+	CaseID       primitive.ObjectID `bson:"case_id" json:"case_id"`
+	ClientID     primitive.ObjectID `bson:"client_id" json:"client_id"`
+	Date         time.Time          `bson:"date" json:"date"`
+	StartAt      *time.Time         `bson:"start_at,omitempty" json:"start_at,omitempty"`
+	EndAt        *time.Time         `bson:"end_at,omitempty" json:"end_at,omitempty"`
+	Minutes      int                `bson:"minutes" json:"minutes"`
+	ActivityCode ActivityCode       `bson:"activity_code" json:"activity_code"`
+	Description  string             `bson:"description" json:"description"`
+	Billable     bool               `bson:"billable" json:"billable"`
+	RateCents    int64              `bson:"rate_cents" json:"rate_cents"`
+	RateSource   RateSource         `bson:"rate_source" json:"rate_source"`
+	AmountCents  int64              `bson:"amount_cents" json:"amount_cents"`
+	Running      bool               `bson:"running" json:"running"`
+	InvoiceID    primitive.ObjectID `bson:"invoice_id,omitempty" json:"invoice_id,omitempty"`
+	CreatedAt    time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// TimeEntryQuery filtra lançamentos pelo dia trabalhado, no intervalo
+// [From, To). Unbilled seleciona os lançamentos faturáveis, concluídos e
+// ainda sem fatura.
+type TimeEntryQuery struct {
+	UserID   string
+	CaseID   string
+	ClientID string
+	From     time.Time
+	To       time.Time
+	Billable *bool
+	Unbilled bool
+}
+
+// TimesheetDay totaliza os minutos de um dia da semana
+type TimesheetDay struct {
+	Date            time.Time `json:"date"`
+	Minutes         int       `json:"minutes"`
+	BillableMinutes int       `json:"billable_minutes"`
+}
+
+// TimesheetBreakdown totaliza a semana por processo (no resumo do usuário)
+// ou por usuário (no resumo do processo)
+type TimesheetBreakdown struct {
+	ID              primitive.ObjectID `json:"id"`
+	Minutes         int                `json:"minutes"`
+	BillableMinutes int                `json:"billable_minutes"`
+	AmountCents     int64              `json:"amount_cents"`
+}
+
+// TimesheetSummary é o resumo semanal (segunda a domingo) de horas lançadas
+type TimesheetSummary struct {
+	WeekStart       time.Time            `json:"week_start"`
+	WeekEnd         time.Time            `json:"week_end"`
+	UserID          primitive.ObjectID   `json:"user_id,omitempty"`
+	CaseID          primitive.ObjectID   `json:"case_id,omitempty"`
+	TotalMinutes    int                  `json:"total_minutes"`
+	BillableMinutes int                  `json:"billable_minutes"`
+	AmountCents     int64                `json:"amount_cents"`
+	Days            []TimesheetDay       `json:"days"`
+	Breakdown       []TimesheetBreakdown `json:"breakdown"`
+}
+
+type TimeEntryRepository interface {
+	Create(entry *TimeEntry) error
+	FindByID(id string) (*TimeEntry, error)
+	FindRunning(userID string) (*TimeEntry, error)
+	Find(query TimeEntryQuery) ([]*TimeEntry, error)
+	Update(entry *TimeEntry) error
+	Delete(id string) error
+	// SetInvoice vincula os lançamentos à fatura; falha se algum deles já
+	// estiver em outra fatura, mantendo os vínculos feitos até então
+	SetInvoice(ids []primitive.ObjectID, invoiceID primitive.ObjectID) error
+	// ReleaseInvoice desvincula os lançamentos da fatura, que voltam a ser faturáveis
+	ReleaseInvoice(invoiceID primitive.ObjectID) error
+}
+
+type TimeEntryService interface {
+	Create(entry *TimeEntry) error
+	GetByID(id string) (*TimeEntry, error)
+	List(query TimeEntryQuery) ([]*TimeEntry, error)
+	Update(entry *TimeEntry) error
+	Delete(id string) error
+	StartTimer(entry *TimeEntry) error
+	StopTimer(userID string) (*TimeEntry, error)
+	GetRunning(userID string) (*TimeEntry, error)
+	WeeklyByUser(userID string, week time.Time) (*TimesheetSummary, error)
+	WeeklyByCase(caseID string, week time.Time) (*TimesheetSummary, error)
+}
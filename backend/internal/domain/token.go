@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RefreshToken representa uma sessão de longa duração. Apenas o hash do
+// token é persistido; tokens rotacionados compartilham o mesmo FamilyID.
+type RefreshToken struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID     primitive.ObjectID `bson:"user_id" json:"user_id"`
+	FamilyID   string             `bson:"family_id" json:"family_id"`
+	Generation int                `bson:"generation" json:"-"`
+	TokenHash  string             `bson:"token_hash" json:"-"`
+	UserAgent  string             `bson:"user_agent" json:"user_agent"`
+	IP         string             `bson:"ip" json:"ip"`
+	ExpiresAt  time.Time          `bson:"expires_at" json:"expires_at"`
+	RevokedAt  *time.Time         `bson:"revoked_at,omitempty" json:"revoked_at,omitempty"`
+	ReplacedBy primitive.ObjectID `bson:"replaced_by,omitempty" json:"replaced_by,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
+}
+
+// TokenPair é o par de tokens entregue ao cliente no login e na renovação
+type TokenPair struct {
+	AccessToken      string    `json:"token"`
+	TokenType        string    `json:"token_type"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	RefreshToken     string    `json:"refresh_token"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+}
+
+type RefreshTokenRepository interface {
+	Create(token *RefreshToken) error
+	FindByHash(hash string) (*RefreshToken, error)
+	MarkRotated(id string, replacedBy primitive.ObjectID) error
+	RevokeFamily(familyID string) error
+	RevokeAllByUser(userID string) error
+}
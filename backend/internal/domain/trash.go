@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Usuários, processos, clientes e documentos excluídos vão para a lixeira:
+// recebem deleted_at/deleted_by, deixam de aparecer nas buscas e podem ser
+// restaurados até serem removidos definitivamente pela limpeza periódica.
+
+// TrashKind identifica o tipo de registro na lixeira
+type TrashKind string
+
+const (
+	TrashKindUser     TrashKind = "users"
+	TrashKindCase     TrashKind = "cases"
+	TrashKindClient   TrashKind = "clients"
+	TrashKindDocument TrashKind = "documents"
+)
+
+// TrashKinds lista os tipos na ordem da remoção definitiva: dependentes antes
+// dos registros de que dependem
+var TrashKinds = []TrashKind{TrashKindDocument, TrashKindCase, TrashKindClient, TrashKindUser}
+
+func (k TrashKind) IsValid() bool {
+	switch k {
+	case TrashKindUser, TrashKindCase, TrashKindClient, TrashKindDocument:
+		return true
+	}
+	return false
+}
+
+// TrashItem é um registro excluído, resumido para a listagem da lixeira
+type TrashItem struct {
+	Kind      TrashKind          `json:"kind"`
+	ID        primitive.ObjectID `json:"id"`
+	Title     string             `json:"title"`
+	DeletedAt time.Time          `json:"deleted_at"`
+	DeletedBy primitive.ObjectID `json:"deleted_by"`
+	// PurgeAt é quando o registro será removido definitivamente
+	PurgeAt *time.Time `json:"purge_at,omitempty"`
+}
+
+// TrashPurge totaliza os registros removidos definitivamente, por tipo
+type TrashPurge struct {
+	Before  time.Time         `json:"before"`
+	Removed map[TrashKind]int `json:"removed"`
+}
+
+func (p *TrashPurge) Total() int {
+	total := 0
+	for _, count := range p.Removed {
+		total += count
+	}
+	return total
+}
+
+// RecordReferenceRepository conta os registros vinculados a processos e
+// clientes, que impedem a exclusão deles, e remove os registros que só
+// existem em função do processo (prazos, compromissos, tarefas, assinaturas
+// de agenda e histórico de status)
+type RecordReferenceRepository interface {
+	CountCaseReferences(caseID string) (map[string]int64, error)
+	CountClientReferences(clientID string) (map[string]int64, error)
+	PurgeCaseRecords(caseID string) error
+}
+
+type TrashService interface {
+	List(kind TrashKind) ([]*TrashItem, error)
+	Restore(kind TrashKind, id string) error
+	Purge(kind TrashKind, id string) error
+	PurgeExpired() (*TrashPurge, error)
+}
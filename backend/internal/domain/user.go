@@ -12,10 +12,18 @@ type User struct {
 	ProfessionalInfo ProfessionalInfo   `bson:"professional_info" json:"professional_info"`
 	Role             string             `bson:"role" json:"role"`
 	Password         string             `bson:"password" json:"-"`
-	IsActive         bool               `bson:"is_active" json:"is_active"`
-	LastLogin        time.Time          `bson:"last_login" json:"last_login"`
-	CreatedAt        time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt        time.Time          `bson:"updated_at" json:"updated_at"`
+	// MustChangePassword obriga a troca da senha temporária antes de usar o sistema
+	MustChangePassword bool               `bson:"must_change_password,omitempty" json:"must_change_password"`
+	PasswordChangedAt  *time.Time         `bson:"password_changed_at,omitempty" json:"password_changed_at,omitempty"`
+	IsActive           bool               `bson:"is_active" json:"is_active"`
+	DeactivatedAt      *time.Time         `bson:"deactivated_at,omitempty" json:"deactivated_at,omitempty"`
+	DeactivatedBy      primitive.ObjectID `bson:"deactivated_by,omitempty" json:"deactivated_by,omitempty"`
+	LastLogin          time.Time          `bson:"last_login" json:"last_login"`
+	TokenGeneration    int                `bson:"token_generation,omitempty" json:"-"`
+	CreatedAt          time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt          time.Time          `bson:"updated_at" json:"updated_at"`
+	DeletedAt          *time.Time         `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	DeletedBy          primitive.ObjectID `bson:"deleted_by,omitempty" json:"deleted_by,omitempty"`
 }
 
 type PersonalInfo struct {
@@ -35,6 +43,8 @@ type ProfessionalInfo struct {
 	HireDate     time.Time          `bson:"hire_date" json:"hire_date"`
 	Department   string             `bson:"department" json:"department"`
 	SupervisorID primitive.ObjectID `bson:"supervisor_id,omitempty" json:"supervisor_id,omitempty"`
+	// HourlyRateCents é o valor da hora do profissional, em centavos
+	HourlyRateCents int64 `bson:"hourly_rate_cents,omitempty" json:"hourly_rate_cents,omitempty"`
 }
 
 type Address struct {
@@ -58,24 +68,41 @@ type UpdateUserRequest struct {
 		Address   Address `json:"address"`
 	} `json:"personal_info"`
 	ProfessionalInfo struct {
-		OABNumber    string   `json:"oab_number"`
-		OABState     string   `json:"oab_state"`
-		Department   string   `json:"department"`
-		Specialties  []string `json:"specialties"`
-		HireDate     string   `json:"hire_date"`
-		SupervisorID string   `json:"supervisor_id"`
+		OABNumber       string   `json:"oab_number"`
+		OABState        string   `json:"oab_state"`
+		Department      string   `json:"department"`
+		Specialties     []string `json:"specialties"`
+		HireDate        string   `json:"hire_date"`
+		SupervisorID    string   `json:"supervisor_id"`
+		HourlyRateCents *int64   `json:"hourly_rate_cents"`
 	} `json:"professional_info"`
 }
 
+// ChangesAdministrativeFields informa se a requisição altera campos que só
+// quem administra usuários pode mudar (departamento, contratação, supervisor e
+// valor da hora), mesmo no próprio perfil
+func (r *UpdateUserRequest) ChangesAdministrativeFields() bool {
+	info := r.ProfessionalInfo
+	return info.Department != "" || info.HireDate != "" || info.SupervisorID != "" || info.HourlyRateCents != nil
+}
+
 type UserRepository interface {
 	Create(user *User) error
 	FindByID(id string) (*User, error)
 	FindByEmail(email string) (*User, error)
 	FindByOAB(oabNumber, oabState string) (*User, error)
 	FindByDepartment(department string) ([]*User, error)
+	FindBySupervisorID(supervisorID string) ([]*User, error)
+	CountByRole(role string) (int64, error)
+	CountActiveByRole(role string) (int64, error)
 	Update(user *User) error
-	Delete(id string) error
+	Delete(id string, deletedBy primitive.ObjectID) error
+	Restore(id string) error
+	FindDeleted(deletedBefore time.Time) ([]*User, error)
+	FindDeletedByID(id string) (*User, error)
+	Purge(id string) error
 	UpdateLastLogin(id string) error
+	RevokeTokens(id string) error
 }
 
 type UserService interface {
@@ -85,7 +112,29 @@ type UserService interface {
 	GetByOAB(oabNumber, oabState string) (*User, error)
 	GetByDepartment(department string) ([]*User, error)
 	Update(user *User) error
-	Delete(id string) error
 	UpdateLastLogin(id string) error
 	HasPermission(userID string, module string, action string) (bool, error)
+	RoleHasPermission(roleName string, module string, action string) (bool, error)
+}
+
+// UserReassignment totaliza o que foi transferido de um usuário para outro
+type UserReassignment struct {
+	FromUserID primitive.ObjectID `json:"from_user_id"`
+	ToUserID   primitive.ObjectID `json:"to_user_id"`
+	Cases      int                `json:"cases"`
+	Tasks      int                `json:"tasks"`
+	Deadlines  int                `json:"deadlines"`
+}
+
+// UserReferenceRepository conta os registros que apontam para um usuário,
+// por coleção, para impedir a exclusão definitiva de quem tem histórico
+type UserReferenceRepository interface {
+	CountReferences(userID string) (map[string]int64, error)
+}
+
+type UserLifecycleService interface {
+	Deactivate(id string, by *User, reassignTo string) (*User, *UserReassignment, error)
+	Reactivate(id string) (*User, error)
+	Reassign(fromID, toID string) (*UserReassignment, error)
+	Delete(id string, by *User) error
 }
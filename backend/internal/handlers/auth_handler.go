@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+)
+
+type AuthHandler struct {
+	authService *services.AuthService
+}
+
+func NewAuthHandler(authService *services.AuthService) *AuthHandler {
+	return &AuthHandler{authService: authService}
+}
+
+type refreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+func sessionInfo(c *gin.Context) services.SessionInfo {
+	return services.SessionInfo{
+		UserAgent: c.Request.UserAgent(),
+		IP:        c.ClientIP(),
+	}
+}
+
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req refreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tokens, err := h.authService.Refresh(req.RefreshToken, sessionInfo(c))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRefreshToken) || errors.Is(err, services.ErrRefreshTokenReused) ||
+			errors.Is(err, services.ErrUserInactive) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "erro ao renovar sessão"})
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
+
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req refreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.authService.Logout(req.RefreshToken); err != nil {
+		if errors.Is(err, services.ErrInvalidRefreshToken) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "erro ao encerrar sessão"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "sessão encerrada com sucesso"})
+}
+
+// LogoutAll encerra todas as sessões do usuário autenticado
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+	user, ok := middleware.CurrentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "usuário não autenticado"})
+		return
+	}
+
+	if err := h.authService.LogoutAll(user.ID.Hex()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "erro ao encerrar sessões"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "todas as sessões foram encerradas"})
+}
+
+// RevokeUserSessions encerra todas as sessões de um usuário específico
+func (h *AuthHandler) RevokeUserSessions(c *gin.Context) {
+	id := c.Param("id")
+	if err := h.authService.LogoutAll(id); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "usuário não encontrado"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "erro ao encerrar sessões"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "todas as sessões do usuário foram encerradas"})
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CalendarFeedHandler struct {
+	feedService *services.CalendarFeedService
+}
+
+func NewCalendarFeedHandler(feedService *services.CalendarFeedService) *CalendarFeedHandler {
+	return &CalendarFeedHandler{feedService: feedService}
+}
+
+// CreateCalendarFeedRequest cria a assinatura da agenda do usuário ou, com
+// case_id, a de um processo
+type CreateCalendarFeedRequest struct {
+	Name   string `json:"name"`
+	CaseID string `json:"case_id"`
+}
+
+// feedURL monta a URL pública de assinatura a partir da requisição atual
+func feedURL(c *gin.Context, token string) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return scheme + "://" + c.Request.Host + "/api/feeds/" + token + ".ics"
+}
+
+func (h *CalendarFeedHandler) Create(c *gin.Context) {
+	var req CreateCalendarFeedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	feed := &domain.CalendarFeed{
+		UserID: user.ID,
+		Name:   req.Name,
+	}
+	if req.CaseID != "" {
+		caseID, err := primitive.ObjectIDFromHex(req.CaseID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do processo inválido"})
+			return
+		}
+		feed.CaseID = caseID
+	}
+
+	token, err := h.feedService.Create(feed)
+	if err != nil {
+		handleCalendarFeedError(c, err)
+		return
+	}
+
+	// O token só é exibido na criação; depois disso apenas o hash é conhecido
+	c.JSON(http.StatusCreated, gin.H{
+		"feed":  feed,
+		"token": token,
+		"url":   feedURL(c, token),
+	})
+}
+
+// List retorna as assinaturas do usuário autenticado
+func (h *CalendarFeedHandler) List(c *gin.Context) {
+	user, _ := middleware.CurrentUser(c)
+	feeds, err := h.feedService.ListByUser(user.ID.Hex())
+	if err != nil {
+		handleCalendarFeedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, feeds)
+}
+
+func (h *CalendarFeedHandler) Revoke(c *gin.Context) {
+	user, _ := middleware.CurrentUser(c)
+	if err := h.feedService.Revoke(user.ID.Hex(), c.Param("id")); err != nil {
+		handleCalendarFeedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "assinatura revogada com sucesso"})
+}
+
+// RevokeAll revoga todas as assinaturas do usuário autenticado
+func (h *CalendarFeedHandler) RevokeAll(c *gin.Context) {
+	user, _ := middleware.CurrentUser(c)
+	if err := h.feedService.RevokeAll(user.ID.Hex()); err != nil {
+		handleCalendarFeedError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "assinaturas revogadas com sucesso"})
+}
+
+// Feed entrega o arquivo iCalendar da assinatura. A rota é pública: o token
+// da URL é a credencial, pois aplicativos de calendário não enviam o JWT.
+func (h *CalendarFeedHandler) Feed(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	content, err := h.feedService.Render(token)
+	if err != nil {
+		handleCalendarFeedError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="jurisconnect.ics"`)
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, calendar.ICSContentType, content)
+}
+
+func handleCalendarFeedError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrInvalidFeedToken), errors.Is(err, repositories.ErrCalendarFeedNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CaseHandler struct {
+	caseService *services.CaseService
+}
+
+func NewCaseHandler(caseService *services.CaseService) *CaseHandler {
+	return &CaseHandler{caseService: caseService}
+}
+
+type CreateCaseRequest struct {
+	Number           string                   `json:"number"`
+	Title            string                   `json:"title" binding:"required"`
+	Description      string                   `json:"description"`
+	ClientID         string                   `json:"client_id" binding:"required"`
+	LawyerID         string                   `json:"lawyer_id"`
+	Parties          []domain.CaseParty       `json:"parties"`
+	Team             []domain.CaseTeamMember  `json:"team"`
+	ConflictOverride *ConflictOverrideRequest `json:"conflict_override"`
+}
+
+type TransitionCaseStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+	Reason string `json:"reason" binding:"required"`
+}
+
+type UpdateCaseRequest struct {
+	Number           string                   `json:"number"`
+	Title            string                   `json:"title"`
+	Description      string                   `json:"description"`
+	ClientID         string                   `json:"client_id"`
+	LawyerID         string                   `json:"lawyer_id"`
+	Parties          []domain.CaseParty       `json:"parties"`
+	Team             []domain.CaseTeamMember  `json:"team"`
+	ConflictOverride *ConflictOverrideRequest `json:"conflict_override"`
+}
+
+func (h *CaseHandler) Create(c *gin.Context) {
+	var req CreateCaseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	clientID, err := primitive.ObjectIDFromHex(req.ClientID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do cliente inválido"})
+		return
+	}
+
+	user, _ := middleware.CurrentUser(c)
+
+	// Sem advogado informado, o processo fica com o usuário autenticado
+	lawyerID := user.ID
+	if req.LawyerID != "" {
+		lawyerID, err = primitive.ObjectIDFromHex(req.LawyerID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do advogado inválido"})
+			return
+		}
+	}
+
+	case_ := &domain.Case{
+		Number:           req.Number,
+		Title:            req.Title,
+		Description:      req.Description,
+		ClientID:         clientID,
+		LawyerID:         lawyerID,
+		Parties:          req.Parties,
+		Team:             req.Team,
+		CreatedBy:        user.ID,
+		ConflictOverride: req.ConflictOverride.toDomain(),
+	}
+
+	if err := h.caseService.Create(case_); err != nil {
+		handleCaseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, case_)
+}
+
+func (h *CaseHandler) GetByID(c *gin.Context) {
+	case_, err := h.caseService.GetByID(c.Param("id"))
+	if err != nil {
+		handleCaseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, case_)
+}
+
+func (h *CaseHandler) GetByNumber(c *gin.Context) {
+	case_, err := h.caseService.GetByNumber(c.Param("number"))
+	if err != nil {
+		handleCaseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, case_)
+}
+
+func (h *CaseHandler) GetByClientID(c *gin.Context) {
+	cases, err := h.caseService.GetByClientID(c.Param("clientId"))
+	if err != nil {
+		handleCaseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, cases)
+}
+
+func (h *CaseHandler) GetByLawyerID(c *gin.Context) {
+	cases, err := h.caseService.GetByLawyerID(c.Param("lawyerId"))
+	if err != nil {
+		handleCaseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, cases)
+}
+
+// GetByParty busca processos em que a pessoa é parte, via ?document=, ?name=,
+// ?client_id= e, opcionalmente, ?side=
+func (h *CaseHandler) GetByParty(c *gin.Context) {
+	cases, err := h.caseService.GetByParty(domain.PartyQuery{
+		Document: c.Query("document"),
+		Name:     c.Query("name"),
+		ClientID: c.Query("client_id"),
+		Side:     domain.PartySide(c.Query("side")),
+	})
+	if err != nil {
+		handleCaseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, cases)
+}
+
+func (h *CaseHandler) GetByTeamMember(c *gin.Context) {
+	cases, err := h.caseService.GetByTeamMember(c.Param("userId"))
+	if err != nil {
+		handleCaseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, cases)
+}
+
+func (h *CaseHandler) Update(c *gin.Context) {
+	var req UpdateCaseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	case_, err := h.caseService.GetByID(c.Param("id"))
+	if err != nil {
+		handleCaseError(c, err)
+		return
+	}
+
+	if req.Number != "" {
+		case_.Number = req.Number
+	}
+	if req.Title != "" {
+		case_.Title = req.Title
+	}
+	if req.Description != "" {
+		case_.Description = req.Description
+	}
+	if req.ClientID != "" {
+		clientID, err := primitive.ObjectIDFromHex(req.ClientID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do cliente inválido"})
+			return
+		}
+		case_.ClientID = clientID
+	}
+	if req.LawyerID != "" {
+		lawyerID, err := primitive.ObjectIDFromHex(req.LawyerID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do advogado inválido"})
+			return
+		}
+		case_.LawyerID = lawyerID
+	}
+	// Listas enviadas substituem as atuais; ausentes mantêm as existentes
+	if req.Parties != nil {
+		case_.Parties = req.Parties
+	}
+	if req.Team != nil {
+		case_.Team = req.Team
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	if err := h.caseService.Update(case_, req.ConflictOverride.toDomain(), user.ID); err != nil {
+		handleCaseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, case_)
+}
+
+func (h *CaseHandler) TransitionStatus(c *gin.Context) {
+	var req TransitionCaseStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	case_, err := h.caseService.TransitionStatus(c.Param("id"), domain.CaseStatus(req.Status), req.Reason, user.ID)
+	if err != nil {
+		handleCaseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, case_)
+}
+
+// GetStatusHistory aceita o filtro ?status= para responder, por exemplo,
+// quando o processo entrou em recurso
+func (h *CaseHandler) GetStatusHistory(c *gin.Context) {
+	history, err := h.caseService.GetStatusHistory(c.Param("id"), domain.CaseStatus(c.Query("status")))
+	if err != nil {
+		handleCaseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+func (h *CaseHandler) Delete(c *gin.Context) {
+	user, _ := middleware.CurrentUser(c)
+	if err := h.caseService.Delete(c.Param("id"), user.ID); err != nil {
+		handleCaseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "processo movido para a lixeira"})
+}
+
+func handleCaseError(c *gin.Context, err error) {
+	var referencesErr *services.RecordReferencesError
+	switch {
+	case errors.Is(err, services.ErrConflictOfInterest):
+		respondConflictOfInterest(c, err)
+	case errors.As(err, &referencesErr):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "references": referencesErr.References})
+	case errors.Is(err, repositories.ErrCaseNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrDuplicateCaseNumber), errors.Is(err, repositories.ErrCaseStatusConflict):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrInvalidStatusTransition):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+)
+
+type ClientHandler struct {
+	clientService *services.ClientService
+}
+
+func NewClientHandler(clientService *services.ClientService) *ClientHandler {
+	return &ClientHandler{clientService: clientService}
+}
+
+type CreateClientRequest struct {
+	Type              string                   `json:"type" binding:"required"`
+	Name              string                   `json:"name" binding:"required"`
+	TradeName         string                   `json:"trade_name"`
+	Document          string                   `json:"document" binding:"required"`
+	RG                string                   `json:"rg"`
+	BirthDate         string                   `json:"birth_date"`
+	StateRegistration string                   `json:"state_registration"`
+	Email             string                   `json:"email"`
+	Phone             string                   `json:"phone"`
+	Contacts          []domain.ClientContact   `json:"contacts"`
+	Addresses         []domain.Address         `json:"addresses"`
+	Notes             string                   `json:"notes"`
+	HourlyRateCents   int64                    `json:"hourly_rate_cents"`
+	ConflictOverride  *ConflictOverrideRequest `json:"conflict_override"`
+}
+
+type UpdateClientRequest struct {
+	Name              string                   `json:"name"`
+	TradeName         string                   `json:"trade_name"`
+	Document          string                   `json:"document"`
+	RG                string                   `json:"rg"`
+	BirthDate         string                   `json:"birth_date"`
+	StateRegistration string                   `json:"state_registration"`
+	Email             string                   `json:"email"`
+	Phone             string                   `json:"phone"`
+	Contacts          []domain.ClientContact   `json:"contacts"`
+	Addresses         []domain.Address         `json:"addresses"`
+	Notes             string                   `json:"notes"`
+	HourlyRateCents   *int64                   `json:"hourly_rate_cents"`
+	IsActive          *bool                    `json:"is_active"`
+	ConflictOverride  *ConflictOverrideRequest `json:"conflict_override"`
+}
+
+func (h *ClientHandler) Create(c *gin.Context) {
+	var req CreateClientRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var birthDate time.Time
+	if req.BirthDate != "" {
+		parsed, err := time.Parse("2006-01-02", req.BirthDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "data de nascimento inválida, use o formato AAAA-MM-DD"})
+			return
+		}
+		birthDate = parsed
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	client := &domain.Client{
+		Type:              domain.ClientType(req.Type),
+		Name:              req.Name,
+		TradeName:         req.TradeName,
+		Document:          req.Document,
+		RG:                req.RG,
+		BirthDate:         birthDate,
+		StateRegistration: req.StateRegistration,
+		Email:             req.Email,
+		Phone:             req.Phone,
+		Contacts:          req.Contacts,
+		Addresses:         req.Addresses,
+		Notes:             req.Notes,
+		HourlyRateCents:   req.HourlyRateCents,
+		CreatedBy:         user.ID,
+		ConflictOverride:  req.ConflictOverride.toDomain(),
+	}
+
+	if err := h.clientService.Create(client); err != nil {
+		handleClientError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, client)
+}
+
+// Search lista clientes filtrando por ?q= (trecho do nome), ?document=
+// (início do CPF/CNPJ) e ?type= (pf ou pj)
+func (h *ClientHandler) Search(c *gin.Context) {
+	clients, err := h.clientService.Search(domain.ClientQuery{
+		Name:     c.Query("q"),
+		Document: c.Query("document"),
+		Type:     domain.ClientType(c.Query("type")),
+	})
+	if err != nil {
+		handleClientError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, clients)
+}
+
+func (h *ClientHandler) GetByID(c *gin.Context) {
+	client, err := h.clientService.GetByID(c.Param("id"))
+	if err != nil {
+		handleClientError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, client)
+}
+
+func (h *ClientHandler) GetByDocument(c *gin.Context) {
+	client, err := h.clientService.GetByDocument(c.Param("document"))
+	if err != nil {
+		handleClientError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, client)
+}
+
+func (h *ClientHandler) Update(c *gin.Context) {
+	var req UpdateClientRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	client, err := h.clientService.GetByID(c.Param("id"))
+	if err != nil {
+		handleClientError(c, err)
+		return
+	}
+
+	if req.Name != "" {
+		client.Name = req.Name
+	}
+	if req.TradeName != "" {
+		client.TradeName = req.TradeName
+	}
+	if req.Document != "" {
+		client.Document = req.Document
+	}
+	if req.RG != "" {
+		client.RG = req.RG
+	}
+	if req.BirthDate != "" {
+		birthDate, err := time.Parse("2006-01-02", req.BirthDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "data de nascimento inválida, use o formato AAAA-MM-DD"})
+			return
+		}
+		client.BirthDate = birthDate
+	}
+	if req.StateRegistration != "" {
+		client.StateRegistration = req.StateRegistration
+	}
+	if req.Email != "" {
+		client.Email = req.Email
+	}
+	if req.Phone != "" {
+		client.Phone = req.Phone
+	}
+	if req.Notes != "" {
+		client.Notes = req.Notes
+	}
+	if req.HourlyRateCents != nil {
+		client.HourlyRateCents = *req.HourlyRateCents
+	}
+	if req.IsActive != nil {
+		client.IsActive = *req.IsActive
+	}
+	// Listas enviadas substituem as atuais; ausentes mantêm as existentes
+	if req.Contacts != nil {
+		client.Contacts = req.Contacts
+	}
+	if req.Addresses != nil {
+		client.Addresses = req.Addresses
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	if err := h.clientService.Update(client, req.ConflictOverride.toDomain(), user.ID); err != nil {
+		handleClientError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, client)
+}
+
+func (h *ClientHandler) Delete(c *gin.Context) {
+	user, _ := middleware.CurrentUser(c)
+	if err := h.clientService.Delete(c.Param("id"), user.ID); err != nil {
+		handleClientError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "cliente movido para a lixeira"})
+}
+
+func handleClientError(c *gin.Context, err error) {
+	var referencesErr *services.RecordReferencesError
+	switch {
+	case errors.Is(err, services.ErrConflictOfInterest):
+		respondConflictOfInterest(c, err)
+	case errors.As(err, &referencesErr):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "references": referencesErr.References})
+	case errors.Is(err, repositories.ErrClientNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrDuplicateClientDocument):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
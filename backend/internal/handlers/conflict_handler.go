@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+)
+
+// ErrCodeConflictOfInterest identifica a resposta de cadastro bloqueado por conflito
+const ErrCodeConflictOfInterest = "CONFLICT_OF_INTEREST"
+
+type ConflictHandler struct {
+	conflictService *services.ConflictService
+}
+
+func NewConflictHandler(conflictService *services.ConflictService) *ConflictHandler {
+	return &ConflictHandler{conflictService: conflictService}
+}
+
+type ConflictCheckRequest struct {
+	Subjects []domain.ConflictSubject `json:"subjects" binding:"required"`
+}
+
+// ConflictOverrideRequest é enviado no cadastro de clientes e processos para
+// prosseguir mesmo com conflito de interesses
+type ConflictOverrideRequest struct {
+	Acknowledged bool   `json:"acknowledged"`
+	Reason       string `json:"reason"`
+}
+
+func (r *ConflictOverrideRequest) toDomain() *domain.ConflictOverride {
+	if r == nil {
+		return nil
+	}
+	return &domain.ConflictOverride{Acknowledged: r.Acknowledged, Reason: r.Reason}
+}
+
+func (h *ConflictHandler) Check(c *gin.Context) {
+	var req ConflictCheckRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := h.conflictService.Check(req.Subjects)
+	if err != nil {
+		handleConflictError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// ListOverrides aceita ?target_type=client|case&target_id= para filtrar um cadastro
+func (h *ConflictHandler) ListOverrides(c *gin.Context) {
+	checks, err := h.conflictService.ListOverrides(c.Query("target_type"), c.Query("target_id"))
+	if err != nil {
+		handleConflictError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, checks)
+}
+
+// respondConflictOfInterest devolve o relatório que bloqueou o cadastro
+func respondConflictOfInterest(c *gin.Context, err error) {
+	var conflictErr *services.ConflictError
+	if errors.As(err, &conflictErr) {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":  err.Error(),
+			"code":   ErrCodeConflictOfInterest,
+			"report": conflictErr.Report,
+		})
+		return
+	}
+	c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "code": ErrCodeConflictOfInterest})
+}
+
+func handleConflictError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
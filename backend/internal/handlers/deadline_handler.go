@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type DeadlineHandler struct {
+	deadlineService *services.DeadlineService
+}
+
+func NewDeadlineHandler(deadlineService *services.DeadlineService) *DeadlineHandler {
+	return &DeadlineHandler{deadlineService: deadlineService}
+}
+
+// DeadlineCalculationRequest recebe as datas no formato AAAA-MM-DD
+type DeadlineCalculationRequest struct {
+	StartEvent   string `json:"start_event"`
+	StartDate    string `json:"start_date" binding:"required"`
+	TermDays     int    `json:"term_days" binding:"required"`
+	CountingMode string `json:"counting_mode"`
+	Doubled      bool   `json:"doubled"`
+	State        string `json:"state"`
+	Court        string `json:"court"`
+}
+
+func (r DeadlineCalculationRequest) toDomain() (domain.DeadlineCalculation, error) {
+	startDate, err := calendar.ParseDate(r.StartDate)
+	if err != nil {
+		return domain.DeadlineCalculation{}, err
+	}
+	return domain.DeadlineCalculation{
+		StartEvent:   domain.DeadlineStartEvent(r.StartEvent),
+		StartDate:    startDate,
+		TermDays:     r.TermDays,
+		CountingMode: domain.DeadlineCountingMode(r.CountingMode),
+		Doubled:      r.Doubled,
+		State:        r.State,
+		Court:        r.Court,
+	}, nil
+}
+
+type CreateDeadlineRequest struct {
+	DeadlineCalculationRequest
+	Title       string `json:"title" binding:"required"`
+	Description string `json:"description"`
+	AssignedTo  string `json:"assigned_to"`
+}
+
+type UpdateDeadlineRequest struct {
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	Status       string `json:"status"`
+	AssignedTo   string `json:"assigned_to"`
+	StartEvent   string `json:"start_event"`
+	StartDate    string `json:"start_date"`
+	TermDays     *int   `json:"term_days"`
+	CountingMode string `json:"counting_mode"`
+	Doubled      *bool  `json:"doubled"`
+	State        string `json:"state"`
+	Court        string `json:"court"`
+}
+
+// Calculate simula o vencimento de um prazo sem gravá-lo
+func (h *DeadlineHandler) Calculate(c *gin.Context) {
+	var req DeadlineCalculationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	params, err := req.toDomain()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.deadlineService.Calculate(params)
+	if err != nil {
+		handleDeadlineError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *DeadlineHandler) Create(c *gin.Context) {
+	var req CreateDeadlineRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	caseID, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do processo inválido"})
+		return
+	}
+
+	params, err := req.toDomain()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var assignedTo primitive.ObjectID
+	if req.AssignedTo != "" {
+		assignedTo, err = primitive.ObjectIDFromHex(req.AssignedTo)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do responsável inválido"})
+			return
+		}
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	deadline := &domain.Deadline{
+		CaseID:              caseID,
+		Title:               req.Title,
+		Description:         req.Description,
+		DeadlineCalculation: params,
+		AssignedTo:          assignedTo,
+		CreatedBy:           user.ID,
+	}
+
+	if err := h.deadlineService.Create(deadline); err != nil {
+		handleDeadlineError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, deadline)
+}
+
+// GetByCaseID aceita o filtro ?status=pendente|cumprido|cancelado
+func (h *DeadlineHandler) GetByCaseID(c *gin.Context) {
+	deadlines, err := h.deadlineService.GetByCaseID(c.Param("id"), domain.DeadlineStatus(c.Query("status")))
+	if err != nil {
+		handleDeadlineError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, deadlines)
+}
+
+func (h *DeadlineHandler) GetByID(c *gin.Context) {
+	deadline, err := h.deadlineService.GetByID(c.Param("id"), c.Param("deadlineId"))
+	if err != nil {
+		handleDeadlineError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, deadline)
+}
+
+func (h *DeadlineHandler) Update(c *gin.Context) {
+	var req UpdateDeadlineRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	deadline, err := h.deadlineService.GetByID(c.Param("id"), c.Param("deadlineId"))
+	if err != nil {
+		handleDeadlineError(c, err)
+		return
+	}
+
+	if req.Title != "" {
+		deadline.Title = req.Title
+	}
+	if req.Description != "" {
+		deadline.Description = req.Description
+	}
+	if req.Status != "" {
+		deadline.Status = domain.DeadlineStatus(req.Status)
+	}
+	if req.AssignedTo != "" {
+		assignedTo, err := primitive.ObjectIDFromHex(req.AssignedTo)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do responsável inválido"})
+			return
+		}
+		deadline.AssignedTo = assignedTo
+	}
+	if req.StartEvent != "" {
+		deadline.StartEvent = domain.DeadlineStartEvent(req.StartEvent)
+	}
+	if req.StartDate != "" {
+		startDate, err := calendar.ParseDate(req.StartDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		deadline.StartDate = startDate
+	}
+	if req.TermDays != nil {
+		deadline.TermDays = *req.TermDays
+	}
+	if req.CountingMode != "" {
+		deadline.CountingMode = domain.DeadlineCountingMode(req.CountingMode)
+	}
+	if req.Doubled != nil {
+		deadline.Doubled = *req.Doubled
+	}
+	if req.State != "" {
+		deadline.State = req.State
+	}
+	if req.Court != "" {
+		deadline.Court = req.Court
+	}
+
+	if err := h.deadlineService.Update(deadline); err != nil {
+		handleDeadlineError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, deadline)
+}
+
+func (h *DeadlineHandler) Delete(c *gin.Context) {
+	if err := h.deadlineService.Delete(c.Param("id"), c.Param("deadlineId")); err != nil {
+		handleDeadlineError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "prazo removido com sucesso"})
+}
+
+func handleDeadlineError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repositories.ErrDeadlineNotFound), errors.Is(err, repositories.ErrCaseNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
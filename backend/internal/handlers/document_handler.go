@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+	"github.com/jurisconnect/backend/internal/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type DocumentHandler struct {
+	documentService *services.DocumentService
+	maxUploadSize   int64
+}
+
+func NewDocumentHandler(documentService *services.DocumentService, maxUploadSize int64) *DocumentHandler {
+	return &DocumentHandler{documentService: documentService, maxUploadSize: maxUploadSize}
+}
+
+type UpdateDocumentRequest struct {
+	Title       string `json:"title" binding:"required"`
+	Description string `json:"description"`
+}
+
+// Upload recebe um multipart/form-data com os campos "file", "title" e "description"
+func (h *DocumentHandler) Upload(c *gin.Context) {
+	caseID, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do processo inválido"})
+		return
+	}
+
+	upload, file, ok := readUpload(c, h.maxUploadSize)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	title := c.PostForm("title")
+	if title == "" {
+		title = upload.FileName
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	document := &domain.Document{
+		Title:       title,
+		Description: c.PostForm("description"),
+		CaseID:      caseID,
+		CreatedBy:   user.ID,
+	}
+
+	if err := h.documentService.Upload(document, upload); err != nil {
+		handleDocumentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, document)
+}
+
+// AddVersion recebe um multipart/form-data com os campos "file" e "comment"
+func (h *DocumentHandler) AddVersion(c *gin.Context) {
+	upload, file, ok := readUpload(c, h.maxUploadSize)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	user, _ := middleware.CurrentUser(c)
+	document, err := h.documentService.AddVersion(c.Param("id"), upload, c.PostForm("comment"), user.ID)
+	if err != nil {
+		handleDocumentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, document)
+}
+
+func (h *DocumentHandler) GetVersions(c *gin.Context) {
+	versions, err := h.documentService.GetVersions(c.Param("id"))
+	if err != nil {
+		handleDocumentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, versions)
+}
+
+func (h *DocumentHandler) DownloadVersion(c *gin.Context) {
+	number, ok := versionParam(c)
+	if !ok {
+		return
+	}
+
+	version, content, err := h.documentService.OpenVersion(c.Param("id"), number)
+	if err != nil {
+		handleDocumentError(c, err)
+		return
+	}
+	defer content.Close()
+
+	streamFile(c, version.FileName, version.MimeType, version.Size, version.SHA256, content)
+}
+
+func (h *DocumentHandler) RestoreVersion(c *gin.Context) {
+	number, ok := versionParam(c)
+	if !ok {
+		return
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	document, err := h.documentService.RestoreVersion(c.Param("id"), number, user.ID)
+	if err != nil {
+		handleDocumentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, document)
+}
+
+func (h *DocumentHandler) GetByCaseID(c *gin.Context) {
+	documents, err := h.documentService.GetByCaseID(c.Param("id"))
+	if err != nil {
+		handleDocumentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, documents)
+}
+
+func (h *DocumentHandler) GetByID(c *gin.Context) {
+	document, err := h.documentService.GetByID(c.Param("id"))
+	if err != nil {
+		handleDocumentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, document)
+}
+
+// Download transmite o arquivo com o tipo de conteúdo detectado no upload
+func (h *DocumentHandler) Download(c *gin.Context) {
+	document, content, err := h.documentService.Open(c.Param("id"))
+	if err != nil {
+		handleDocumentError(c, err)
+		return
+	}
+	defer content.Close()
+
+	streamFile(c, document.FileName, document.MimeType, document.Size, document.SHA256, content)
+}
+
+func (h *DocumentHandler) Update(c *gin.Context) {
+	documentID, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var req UpdateDocumentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	document := &domain.Document{
+		ID:          documentID,
+		Title:       req.Title,
+		Description: req.Description,
+	}
+	if err := h.documentService.Update(document); err != nil {
+		handleDocumentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, document)
+}
+
+func (h *DocumentHandler) Delete(c *gin.Context) {
+	user, _ := middleware.CurrentUser(c)
+	if err := h.documentService.Delete(c.Param("id"), user.ID); err != nil {
+		handleDocumentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "documento movido para a lixeira"})
+}
+
+// readUpload lê o arquivo do campo "file", respeitando o tamanho máximo de
+// upload. Em caso de falha a resposta de erro já foi escrita.
+func readUpload(c *gin.Context, maxUploadSize int64) (domain.FileUpload, multipart.File, bool) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxUploadSize)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("arquivo excede o limite de %d bytes", maxUploadSize)})
+			return domain.FileUpload{}, nil, false
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "arquivo não enviado no campo \"file\""})
+		return domain.FileUpload{}, nil, false
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "erro ao ler arquivo enviado"})
+		return domain.FileUpload{}, nil, false
+	}
+
+	return domain.FileUpload{
+		FileName: fileHeader.Filename,
+		Size:     fileHeader.Size,
+		Content:  file,
+	}, file, true
+}
+
+func versionParam(c *gin.Context) (int, bool) {
+	number, err := strconv.Atoi(c.Param("version"))
+	if err != nil || number < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "número de versão inválido"})
+		return 0, false
+	}
+	return number, true
+}
+
+// streamFile envia o conteúdo como anexo, com cabeçalhos de tipo, tamanho e hash
+func streamFile(c *gin.Context, fileName, mimeType string, size int64, sha256 string, content io.Reader) {
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+
+	c.Header("Content-Type", mimeType)
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	if size > 0 {
+		c.Header("Content-Length", strconv.FormatInt(size, 10))
+	}
+	if sha256 != "" {
+		c.Header("ETag", `"`+sha256+`"`)
+	}
+	c.Status(http.StatusOK)
+
+	if _, err := io.Copy(c.Writer, content); err != nil {
+		log.Printf("Erro ao transmitir arquivo %s: %v", fileName, err)
+	}
+}
+
+func handleDocumentError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repositories.ErrDocumentNotFound), errors.Is(err, repositories.ErrCaseNotFound),
+		errors.Is(err, repositories.ErrDocumentVersionNotFound), errors.Is(err, storage.ErrObjectNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrDuplicateDocumentVersion):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,294 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrCodeEventOverlap identifica a resposta 409 de compromissos sobrepostos
+const ErrCodeEventOverlap = "EVENT_OVERLAP"
+
+type EventHandler struct {
+	eventService *services.EventService
+}
+
+func NewEventHandler(eventService *services.EventService) *EventHandler {
+	return &EventHandler{eventService: eventService}
+}
+
+// CreateEventRequest recebe horários em RFC 3339. AllowOverlap confirma o
+// agendamento mesmo com sobreposição na agenda dos envolvidos.
+type CreateEventRequest struct {
+	Type         string                  `json:"type" binding:"required"`
+	Title        string                  `json:"title" binding:"required"`
+	Description  string                  `json:"description"`
+	Location     string                  `json:"location"`
+	StartAt      time.Time               `json:"start_at" binding:"required"`
+	EndAt        time.Time               `json:"end_at" binding:"required"`
+	CaseID       string                  `json:"case_id"`
+	LawyerID     string                  `json:"lawyer_id"`
+	Participants []string                `json:"participants"`
+	Recurrence   *domain.EventRecurrence `json:"recurrence"`
+	AllowOverlap bool                    `json:"allow_overlap"`
+}
+
+type UpdateEventRequest struct {
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	Location     string     `json:"location"`
+	StartAt      *time.Time `json:"start_at"`
+	EndAt        *time.Time `json:"end_at"`
+	LawyerID     string     `json:"lawyer_id"`
+	Participants []string   `json:"participants"`
+	Status       string     `json:"status"`
+	AllowOverlap bool       `json:"allow_overlap"`
+}
+
+func parseObjectIDs(values []string) ([]primitive.ObjectID, error) {
+	ids := make([]primitive.ObjectID, 0, len(values))
+	for _, value := range values {
+		id, err := primitive.ObjectIDFromHex(value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// parseAgendaTime aceita data (AAAA-MM-DD) ou data e hora em RFC 3339
+func parseAgendaTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return calendar.ParseDate(value)
+}
+
+func (h *EventHandler) Create(c *gin.Context) {
+	var req CreateEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	event := &domain.Event{
+		Type:        domain.EventType(req.Type),
+		Title:       req.Title,
+		Description: req.Description,
+		Location:    req.Location,
+		StartAt:     req.StartAt,
+		EndAt:       req.EndAt,
+		Recurrence:  req.Recurrence,
+		CreatedBy:   user.ID,
+	}
+
+	var err error
+	if req.CaseID != "" {
+		event.CaseID, err = primitive.ObjectIDFromHex(req.CaseID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do processo inválido"})
+			return
+		}
+	}
+	if req.LawyerID != "" {
+		event.LawyerID, err = primitive.ObjectIDFromHex(req.LawyerID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do responsável inválido"})
+			return
+		}
+	} else if req.CaseID == "" {
+		// Sem processo nem responsável, o compromisso fica com o usuário autenticado
+		event.LawyerID = user.ID
+	}
+	event.Participants, err = parseObjectIDs(req.Participants)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID de participante inválido"})
+		return
+	}
+
+	events, err := h.eventService.Create(event, req.AllowOverlap)
+	if err != nil {
+		handleEventError(c, err)
+		return
+	}
+
+	// Compromissos recorrentes retornam todas as ocorrências geradas
+	if event.Recurrence != nil {
+		c.JSON(http.StatusCreated, events)
+		return
+	}
+	c.JSON(http.StatusCreated, events[0])
+}
+
+// List consulta a agenda entre ?from= e ?to=, com filtros opcionais
+// ?user_id=, ?case_id=, ?type= e ?status=. Sem usuário nem processo, retorna
+// a agenda do usuário autenticado.
+func (h *EventHandler) List(c *gin.Context) {
+	from, err := parseAgendaTime(c.Query("from"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "data inicial inválida"})
+		return
+	}
+	to, err := parseAgendaTime(c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "data final inválida"})
+		return
+	}
+
+	query := domain.EventQuery{
+		From:   from,
+		To:     to,
+		UserID: c.Query("user_id"),
+		CaseID: c.Query("case_id"),
+		Type:   domain.EventType(c.Query("type")),
+		Status: domain.EventStatus(c.Query("status")),
+	}
+	if query.UserID == "" && query.CaseID == "" {
+		user, _ := middleware.CurrentUser(c)
+		query.UserID = user.ID.Hex()
+	}
+
+	h.list(c, query)
+}
+
+// GetByCaseID lista os compromissos do processo; ?from= e ?to= são opcionais
+func (h *EventHandler) GetByCaseID(c *gin.Context) {
+	from, err := parseAgendaTime(c.Query("from"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "data inicial inválida"})
+		return
+	}
+	to, err := parseAgendaTime(c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "data final inválida"})
+		return
+	}
+
+	h.list(c, domain.EventQuery{
+		From:   from,
+		To:     to,
+		CaseID: c.Param("id"),
+		Type:   domain.EventType(c.Query("type")),
+		Status: domain.EventStatus(c.Query("status")),
+	})
+}
+
+func (h *EventHandler) list(c *gin.Context, query domain.EventQuery) {
+	events, err := h.eventService.List(query)
+	if err != nil {
+		handleEventError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+func (h *EventHandler) GetByID(c *gin.Context) {
+	event, err := h.eventService.GetByID(c.Param("id"))
+	if err != nil {
+		handleEventError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+// Update altera apenas a ocorrência informada, inclusive em séries recorrentes
+func (h *EventHandler) Update(c *gin.Context) {
+	var req UpdateEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	event, err := h.eventService.GetByID(c.Param("id"))
+	if err != nil {
+		handleEventError(c, err)
+		return
+	}
+
+	if req.Title != "" {
+		event.Title = req.Title
+	}
+	if req.Description != "" {
+		event.Description = req.Description
+	}
+	if req.Location != "" {
+		event.Location = req.Location
+	}
+	if req.StartAt != nil {
+		event.StartAt = *req.StartAt
+	}
+	if req.EndAt != nil {
+		event.EndAt = *req.EndAt
+	}
+	if req.Status != "" {
+		event.Status = domain.EventStatus(req.Status)
+	}
+	if req.LawyerID != "" {
+		lawyerID, err := primitive.ObjectIDFromHex(req.LawyerID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do responsável inválido"})
+			return
+		}
+		event.LawyerID = lawyerID
+	}
+	// Listas enviadas substituem as atuais; ausentes mantêm as existentes
+	if req.Participants != nil {
+		event.Participants, err = parseObjectIDs(req.Participants)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID de participante inválido"})
+			return
+		}
+	}
+
+	if err := h.eventService.Update(event, req.AllowOverlap); err != nil {
+		handleEventError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+// Delete remove o compromisso; com ?scope=series, remove também as
+// ocorrências seguintes da série
+func (h *EventHandler) Delete(c *gin.Context) {
+	deleted, err := h.eventService.Delete(c.Param("id"), c.Query("scope") == "series")
+	if err != nil {
+		handleEventError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "compromisso removido com sucesso", "deleted": deleted})
+}
+
+func handleEventError(c *gin.Context, err error) {
+	var overlapErr *services.EventOverlapError
+	switch {
+	case errors.As(err, &overlapErr):
+		c.JSON(http.StatusConflict, gin.H{
+			"error":    err.Error(),
+			"code":     ErrCodeEventOverlap,
+			"overlaps": overlapErr.Overlaps,
+		})
+	case errors.Is(err, repositories.ErrEventNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
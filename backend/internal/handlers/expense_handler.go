@@ -0,0 +1,256 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ExpenseHandler struct {
+	expenseService *services.ExpenseService
+	maxUploadSize  int64
+}
+
+func NewExpenseHandler(expenseService *services.ExpenseService, maxUploadSize int64) *ExpenseHandler {
+	return &ExpenseHandler{expenseService: expenseService, maxUploadSize: maxUploadSize}
+}
+
+// CreateExpenseRequest recebe o valor em centavos e a data no formato
+// AAAA-MM-DD. Sem bill_to_client, a despesa é cobrada do cliente.
+type CreateExpenseRequest struct {
+	CaseID       string `json:"case_id" binding:"required"`
+	Category     string `json:"category" binding:"required"`
+	Description  string `json:"description" binding:"required"`
+	Date         string `json:"date" binding:"required"`
+	AmountCents  int64  `json:"amount_cents" binding:"required"`
+	BillToClient *bool  `json:"bill_to_client"`
+}
+
+type UpdateExpenseRequest struct {
+	CaseID       string `json:"case_id"`
+	Category     string `json:"category"`
+	Description  string `json:"description"`
+	Date         string `json:"date"`
+	AmountCents  *int64 `json:"amount_cents"`
+	BillToClient *bool  `json:"bill_to_client"`
+}
+
+type RejectExpenseRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+func (h *ExpenseHandler) Create(c *gin.Context) {
+	var req CreateExpenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	caseID, err := primitive.ObjectIDFromHex(req.CaseID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do processo inválido"})
+		return
+	}
+	date, err := calendar.ParseDate(req.Date)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	expense := &domain.Expense{
+		CaseID:       caseID,
+		Category:     domain.ExpenseCategory(req.Category),
+		Description:  req.Description,
+		Date:         date,
+		AmountCents:  req.AmountCents,
+		BillToClient: req.BillToClient == nil || *req.BillToClient,
+		CreatedBy:    user.ID,
+	}
+
+	if err := h.expenseService.Create(expense); err != nil {
+		handleExpenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, expense)
+}
+
+// List aceita os filtros ?case_id=, ?client_id=, ?created_by= e ?status=
+func (h *ExpenseHandler) List(c *gin.Context) {
+	expenses, err := h.expenseService.List(domain.ExpenseQuery{
+		CaseID:    c.Query("case_id"),
+		ClientID:  c.Query("client_id"),
+		CreatedBy: c.Query("created_by"),
+		Status:    domain.ExpenseStatus(c.Query("status")),
+	})
+	if err != nil {
+		handleExpenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, expenses)
+}
+
+func (h *ExpenseHandler) GetByCaseID(c *gin.Context) {
+	expenses, err := h.expenseService.List(domain.ExpenseQuery{
+		CaseID: c.Param("id"),
+		Status: domain.ExpenseStatus(c.Query("status")),
+	})
+	if err != nil {
+		handleExpenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, expenses)
+}
+
+// GetPendingApproval lista as despesas que aguardam a análise do usuário autenticado
+func (h *ExpenseHandler) GetPendingApproval(c *gin.Context) {
+	user, _ := middleware.CurrentUser(c)
+	expenses, err := h.expenseService.GetPendingApproval(user)
+	if err != nil {
+		handleExpenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, expenses)
+}
+
+func (h *ExpenseHandler) GetByID(c *gin.Context) {
+	expense, err := h.expenseService.GetByID(c.Param("id"))
+	if err != nil {
+		handleExpenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, expense)
+}
+
+func (h *ExpenseHandler) Update(c *gin.Context) {
+	var req UpdateExpenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	expense, err := h.expenseService.GetByID(c.Param("id"))
+	if err != nil {
+		handleExpenseError(c, err)
+		return
+	}
+
+	if req.CaseID != "" {
+		expense.CaseID, err = primitive.ObjectIDFromHex(req.CaseID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do processo inválido"})
+			return
+		}
+	}
+	if req.Category != "" {
+		expense.Category = domain.ExpenseCategory(req.Category)
+	}
+	if req.Description != "" {
+		expense.Description = req.Description
+	}
+	if req.Date != "" {
+		expense.Date, err = calendar.ParseDate(req.Date)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if req.AmountCents != nil {
+		expense.AmountCents = *req.AmountCents
+	}
+	if req.BillToClient != nil {
+		expense.BillToClient = *req.BillToClient
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	if err := h.expenseService.Update(expense, user.ID); err != nil {
+		handleExpenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, expense)
+}
+
+func (h *ExpenseHandler) Delete(c *gin.Context) {
+	user, _ := middleware.CurrentUser(c)
+	if err := h.expenseService.Delete(c.Param("id"), user); err != nil {
+		handleExpenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "despesa removida com sucesso"})
+}
+
+// UploadReceipt recebe o comprovante em multipart/form-data no campo "file"
+func (h *ExpenseHandler) UploadReceipt(c *gin.Context) {
+	upload, file, ok := readUpload(c, h.maxUploadSize)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	user, _ := middleware.CurrentUser(c)
+	expense, err := h.expenseService.AttachReceipt(c.Param("id"), upload, user.ID)
+	if err != nil {
+		handleExpenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, expense)
+}
+
+func (h *ExpenseHandler) Approve(c *gin.Context) {
+	user, _ := middleware.CurrentUser(c)
+	expense, err := h.expenseService.Approve(c.Param("id"), user)
+	if err != nil {
+		handleExpenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, expense)
+}
+
+func (h *ExpenseHandler) Reject(c *gin.Context) {
+	var req RejectExpenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	expense, err := h.expenseService.Reject(c.Param("id"), user, req.Reason)
+	if err != nil {
+		handleExpenseError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, expense)
+}
+
+func handleExpenseError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repositories.ErrExpenseNotFound), errors.Is(err, repositories.ErrCaseNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrNotExpenseApprover), errors.Is(err, services.ErrNotExpenseAuthor):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrExpenseInvoiced), errors.Is(err, services.ErrExpenseApproved),
+		errors.Is(err, services.ErrExpenseReviewed), errors.Is(err, repositories.ErrDuplicateDocumentVersion):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
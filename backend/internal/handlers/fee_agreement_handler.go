@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type FeeAgreementHandler struct {
+	agreementService *services.FeeAgreementService
+}
+
+func NewFeeAgreementHandler(agreementService *services.FeeAgreementService) *FeeAgreementHandler {
+	return &FeeAgreementHandler{agreementService: agreementService}
+}
+
+// CreateFeeAgreementRequest recebe valores em centavos e datas no formato AAAA-MM-DD
+type CreateFeeAgreementRequest struct {
+	ClientID          string  `json:"client_id" binding:"required"`
+	CaseID            string  `json:"case_id"`
+	Type              string  `json:"type" binding:"required"`
+	Description       string  `json:"description"`
+	AmountCents       int64   `json:"amount_cents"`
+	HourlyRateCents   int64   `json:"hourly_rate_cents"`
+	SuccessPercentage float64 `json:"success_percentage"`
+	SuccessBaseCents  int64   `json:"success_base_cents"`
+	StartDate         string  `json:"start_date"`
+	EndDate           string  `json:"end_date"`
+}
+
+type UpdateFeeAgreementRequest struct {
+	Description       string   `json:"description"`
+	AmountCents       *int64   `json:"amount_cents"`
+	HourlyRateCents   *int64   `json:"hourly_rate_cents"`
+	SuccessPercentage *float64 `json:"success_percentage"`
+	SuccessBaseCents  *int64   `json:"success_base_cents"`
+	StartDate         string   `json:"start_date"`
+	EndDate           string   `json:"end_date"`
+	IsActive          *bool    `json:"is_active"`
+}
+
+func (h *FeeAgreementHandler) Create(c *gin.Context) {
+	var req CreateFeeAgreementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	clientID, err := primitive.ObjectIDFromHex(req.ClientID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do cliente inválido"})
+		return
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	agreement := &domain.FeeAgreement{
+		ClientID:          clientID,
+		Type:              domain.FeeType(req.Type),
+		Description:       req.Description,
+		AmountCents:       req.AmountCents,
+		HourlyRateCents:   req.HourlyRateCents,
+		SuccessPercentage: req.SuccessPercentage,
+		SuccessBaseCents:  req.SuccessBaseCents,
+		CreatedBy:         user.ID,
+	}
+	if req.CaseID != "" {
+		agreement.CaseID, err = primitive.ObjectIDFromHex(req.CaseID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do processo inválido"})
+			return
+		}
+	}
+	if req.StartDate != "" {
+		agreement.StartDate, err = calendar.ParseDate(req.StartDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if req.EndDate != "" {
+		agreement.EndDate, err = parseDueDate(req.EndDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if err := h.agreementService.Create(agreement); err != nil {
+		handleFeeAgreementError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, agreement)
+}
+
+func (h *FeeAgreementHandler) GetByID(c *gin.Context) {
+	agreement, err := h.agreementService.GetByID(c.Param("id"))
+	if err != nil {
+		handleFeeAgreementError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, agreement)
+}
+
+func (h *FeeAgreementHandler) GetByClientID(c *gin.Context) {
+	agreements, err := h.agreementService.GetByClientID(c.Param("id"))
+	if err != nil {
+		handleFeeAgreementError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, agreements)
+}
+
+func (h *FeeAgreementHandler) Update(c *gin.Context) {
+	var req UpdateFeeAgreementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	agreement, err := h.agreementService.GetByID(c.Param("id"))
+	if err != nil {
+		handleFeeAgreementError(c, err)
+		return
+	}
+
+	if req.Description != "" {
+		agreement.Description = req.Description
+	}
+	if req.AmountCents != nil {
+		agreement.AmountCents = *req.AmountCents
+	}
+	if req.HourlyRateCents != nil {
+		agreement.HourlyRateCents = *req.HourlyRateCents
+	}
+	if req.SuccessPercentage != nil {
+		agreement.SuccessPercentage = *req.SuccessPercentage
+	}
+	if req.SuccessBaseCents != nil {
+		agreement.SuccessBaseCents = *req.SuccessBaseCents
+	}
+	if req.StartDate != "" {
+		agreement.StartDate, err = calendar.ParseDate(req.StartDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if req.EndDate != "" {
+		agreement.EndDate, err = parseDueDate(req.EndDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if req.IsActive != nil {
+		agreement.IsActive = *req.IsActive
+	}
+
+	if err := h.agreementService.Update(agreement); err != nil {
+		handleFeeAgreementError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, agreement)
+}
+
+func (h *FeeAgreementHandler) Delete(c *gin.Context) {
+	if err := h.agreementService.Delete(c.Param("id")); err != nil {
+		handleFeeAgreementError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "contrato de honorários removido com sucesso"})
+}
+
+func handleFeeAgreementError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repositories.ErrFeeAgreementNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrFeeAgreementBilled):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,254 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+)
+
+// maxHolidayImportSize limita o tamanho dos arquivos de feriados importados
+const maxHolidayImportSize = 2 << 20
+
+type HolidayHandler struct {
+	holidayService *services.HolidayService
+}
+
+func NewHolidayHandler(holidayService *services.HolidayService) *HolidayHandler {
+	return &HolidayHandler{holidayService: holidayService}
+}
+
+// HolidayRequest recebe as datas no formato AAAA-MM-DD
+type HolidayRequest struct {
+	Name      string `json:"name" binding:"required"`
+	Kind      string `json:"kind"`
+	Date      string `json:"date" binding:"required"`
+	EndDate   string `json:"end_date"`
+	Scope     string `json:"scope" binding:"required"`
+	State     string `json:"state"`
+	Court     string `json:"court"`
+	Recurring bool   `json:"recurring"`
+}
+
+func (r HolidayRequest) toDomain() (*domain.Holiday, error) {
+	date, err := calendar.ParseDate(r.Date)
+	if err != nil {
+		return nil, err
+	}
+	holiday := &domain.Holiday{
+		Name:      r.Name,
+		Kind:      domain.HolidayKind(r.Kind),
+		Date:      date,
+		Scope:     domain.HolidayScope(r.Scope),
+		State:     r.State,
+		Court:     r.Court,
+		Recurring: r.Recurring,
+	}
+	if r.EndDate != "" {
+		if holiday.EndDate, err = calendar.ParseDate(r.EndDate); err != nil {
+			return nil, err
+		}
+	}
+	return holiday, nil
+}
+
+func (h *HolidayHandler) Create(c *gin.Context) {
+	var req HolidayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	holiday, err := req.toDomain()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	user, _ := middleware.CurrentUser(c)
+	holiday.CreatedBy = user.ID
+
+	if err := h.holidayService.Create(holiday); err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, holiday)
+}
+
+// List aceita os filtros ?year=, ?scope=, ?state= e ?court=
+func (h *HolidayHandler) List(c *gin.Context) {
+	query := domain.HolidayQuery{
+		Scope: domain.HolidayScope(c.Query("scope")),
+		State: c.Query("state"),
+		Court: c.Query("court"),
+	}
+	if year := c.Query("year"); year != "" {
+		value, err := strconv.Atoi(year)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ano inválido"})
+			return
+		}
+		query.Year = value
+	}
+
+	holidays, err := h.holidayService.List(query)
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, holidays)
+}
+
+func (h *HolidayHandler) GetByID(c *gin.Context) {
+	holiday, err := h.holidayService.GetByID(c.Param("id"))
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, holiday)
+}
+
+// Update substitui o feriado pelos dados enviados
+func (h *HolidayHandler) Update(c *gin.Context) {
+	var req HolidayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	existing, err := h.holidayService.GetByID(c.Param("id"))
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	holiday, err := req.toDomain()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	holiday.ID = existing.ID
+
+	if err := h.holidayService.Update(holiday); err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, holiday)
+}
+
+func (h *HolidayHandler) Delete(c *gin.Context) {
+	if err := h.holidayService.Delete(c.Param("id")); err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "feriado removido com sucesso"})
+}
+
+// Import recebe um arquivo .ics ou .csv no campo "file". Os campos de
+// formulário scope, state, court e kind valem para os itens que não os informam.
+func (h *HolidayHandler) Import(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxHolidayImportSize)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("arquivo excede o limite de %d bytes", maxHolidayImportSize)})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "arquivo não enviado no campo \"file\""})
+		return
+	}
+
+	format := c.PostForm("format")
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileHeader.Filename)), ".")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "erro ao ler arquivo enviado"})
+		return
+	}
+	defer file.Close()
+
+	user, _ := middleware.CurrentUser(c)
+	result, err := h.holidayService.Import(format, file, domain.Holiday{
+		Scope:     domain.HolidayScope(c.PostForm("scope")),
+		State:     c.PostForm("state"),
+		Court:     c.PostForm("court"),
+		Kind:      domain.HolidayKind(c.PostForm("kind")),
+		CreatedBy: user.ID,
+	})
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// WorkingDay responde se ?date= é dia útil para ?state= e ?court=
+func (h *HolidayHandler) WorkingDay(c *gin.Context) {
+	date, err := calendar.ParseDate(c.Query("date"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	info, err := h.holidayService.WorkingDay(date, c.Query("state"), c.Query("court"))
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, info)
+}
+
+// NonWorkingDays lista os dias não úteis entre ?from= e ?to= para ?state= e ?court=
+func (h *HolidayHandler) NonWorkingDays(c *gin.Context) {
+	from, err := calendar.ParseDate(c.Query("from"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	to, err := calendar.ParseDate(c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	days, err := h.holidayService.NonWorkingDays(from, to, c.Query("state"), c.Query("court"))
+	if err != nil {
+		handleHolidayError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, days)
+}
+
+func handleHolidayError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repositories.ErrHolidayNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrDuplicateHoliday):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
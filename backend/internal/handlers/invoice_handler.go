@@ -0,0 +1,283 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type InvoiceHandler struct {
+	invoiceService *services.InvoiceService
+}
+
+func NewInvoiceHandler(invoiceService *services.InvoiceService) *InvoiceHandler {
+	return &InvoiceHandler{invoiceService: invoiceService}
+}
+
+// GenerateInvoiceRequest recebe datas no formato AAAA-MM-DD. Sem period_end,
+// fatura até hoje; sem due_date, vence em 15 dias.
+type GenerateInvoiceRequest struct {
+	ClientID    string `json:"client_id" binding:"required"`
+	CaseID      string `json:"case_id"`
+	PeriodStart string `json:"period_start"`
+	PeriodEnd   string `json:"period_end"`
+	DueDate     string `json:"due_date"`
+	Notes       string `json:"notes"`
+}
+
+type UpdateInvoiceRequest struct {
+	DueDate string `json:"due_date"`
+	Notes   string `json:"notes"`
+}
+
+// PayInvoiceRequest registra a quitação; sem valor, considera o total da fatura
+type PayInvoiceRequest struct {
+	PaidAt      *time.Time `json:"paid_at"`
+	AmountCents int64      `json:"amount_cents"`
+}
+
+type CancelInvoiceRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+func (h *InvoiceHandler) Generate(c *gin.Context) {
+	var req GenerateInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	params := domain.InvoiceGeneration{Notes: req.Notes, CreatedBy: user.ID}
+
+	var err error
+	params.ClientID, err = primitive.ObjectIDFromHex(req.ClientID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do cliente inválido"})
+		return
+	}
+	if req.CaseID != "" {
+		params.CaseID, err = primitive.ObjectIDFromHex(req.CaseID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do processo inválido"})
+			return
+		}
+	}
+	if req.PeriodStart != "" {
+		params.PeriodStart, err = parseDueDate(req.PeriodStart)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if req.PeriodEnd != "" {
+		params.PeriodEnd, err = calendar.ParseDate(req.PeriodEnd)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if req.DueDate != "" {
+		params.DueDate, err = calendar.ParseDate(req.DueDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	invoice, err := h.invoiceService.Generate(params)
+	if err != nil {
+		handleInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, invoice)
+}
+
+// List aceita os filtros ?client_id=, ?case_id= e ?status=
+func (h *InvoiceHandler) List(c *gin.Context) {
+	invoices, err := h.invoiceService.List(domain.InvoiceQuery{
+		ClientID: c.Query("client_id"),
+		CaseID:   c.Query("case_id"),
+		Status:   domain.InvoiceStatus(c.Query("status")),
+	})
+	if err != nil {
+		handleInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invoices)
+}
+
+func (h *InvoiceHandler) GetByID(c *gin.Context) {
+	invoice, err := h.invoiceService.GetByID(c.Param("id"))
+	if err != nil {
+		handleInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invoice)
+}
+
+func (h *InvoiceHandler) Update(c *gin.Context) {
+	var req UpdateInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	invoice, err := h.invoiceService.GetByID(c.Param("id"))
+	if err != nil {
+		handleInvoiceError(c, err)
+		return
+	}
+
+	if req.DueDate != "" {
+		invoice.DueDate, err = calendar.ParseDate(req.DueDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if req.Notes != "" {
+		invoice.Notes = req.Notes
+	}
+
+	if err := h.invoiceService.Update(invoice); err != nil {
+		handleInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invoice)
+}
+
+func (h *InvoiceHandler) Issue(c *gin.Context) {
+	invoice, err := h.invoiceService.Issue(c.Param("id"))
+	if err != nil {
+		handleInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invoice)
+}
+
+func (h *InvoiceHandler) Pay(c *gin.Context) {
+	var req PayInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var paidAt time.Time
+	if req.PaidAt != nil {
+		paidAt = *req.PaidAt
+	}
+
+	invoice, err := h.invoiceService.MarkPaid(c.Param("id"), paidAt, req.AmountCents)
+	if err != nil {
+		handleInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invoice)
+}
+
+func (h *InvoiceHandler) Cancel(c *gin.Context) {
+	var req CancelInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	invoice, err := h.invoiceService.Cancel(c.Param("id"), req.Reason)
+	if err != nil {
+		handleInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invoice)
+}
+
+func (h *InvoiceHandler) Delete(c *gin.Context) {
+	if err := h.invoiceService.Delete(c.Param("id")); err != nil {
+		handleInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "fatura removida com sucesso"})
+}
+
+// Statement retorna o extrato de conta do cliente entre ?from= e ?to=
+// (AAAA-MM-DD); sem datas, considera o ano corrente até hoje
+func (h *InvoiceHandler) Statement(c *gin.Context) {
+	today := calendar.Date(time.Now())
+	from := time.Date(today.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := today
+
+	var err error
+	if value := c.Query("from"); value != "" {
+		if from, err = calendar.ParseDate(value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if value := c.Query("to"); value != "" {
+		if to, err = calendar.ParseDate(value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	statement, err := h.invoiceService.Statement(c.Param("id"), from, to)
+	if err != nil {
+		handleInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, statement)
+}
+
+// MonthlySummary retorna o faturamento mensal de ?year= (padrão: ano corrente)
+func (h *InvoiceHandler) MonthlySummary(c *gin.Context) {
+	year := time.Now().Year()
+	if value := c.Query("year"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 2000 || parsed > 9999 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ano inválido"})
+			return
+		}
+		year = parsed
+	}
+
+	summary, err := h.invoiceService.MonthlySummary(year)
+	if err != nil {
+		handleInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+func handleInvoiceError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repositories.ErrInvoiceNotFound), errors.Is(err, repositories.ErrClientNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrInvalidInvoiceTransition), errors.Is(err, services.ErrNothingToInvoice):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrConcurrentInvoice):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+)
+
+type PasswordHandler struct {
+	passwordService *services.PasswordService
+	authService     *services.AuthService
+}
+
+func NewPasswordHandler(passwordService *services.PasswordService, authService *services.AuthService) *PasswordHandler {
+	return &PasswordHandler{passwordService: passwordService, authService: authService}
+}
+
+// ForgotPassword envia o link de redefinição de senha. A resposta é sempre a
+// mesma, exista ou não uma conta com o email informado.
+func (h *PasswordHandler) ForgotPassword(c *gin.Context) {
+	var req struct {
+		Email string `json:"email" binding:"required,email"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.passwordService.RequestReset(req.Email, c.ClientIP()); err != nil {
+		// O limite vale para qualquer email, cadastrado ou não, e não revela contas
+		if errors.Is(err, services.ErrTooManyResetRequests) {
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+			return
+		}
+		log.Printf("Erro ao solicitar redefinição de senha: %v", err)
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "se o email estiver cadastrado, você receberá um link para redefinir a senha"})
+}
+
+// ResetPassword define a nova senha a partir do token enviado por e-mail
+func (h *PasswordHandler) ResetPassword(c *gin.Context) {
+	var req struct {
+		Token       string `json:"token" binding:"required"`
+		NewPassword string `json:"new_password" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.passwordService.ResetPassword(req.Token, req.NewPassword); err != nil {
+		handlePasswordError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "senha redefinida com sucesso"})
+}
+
+// ChangePassword troca a senha do usuário autenticado. As demais sessões são
+// encerradas e a atual recebe novos tokens.
+func (h *PasswordHandler) ChangePassword(c *gin.Context) {
+	user, ok := middleware.CurrentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "usuário não autenticado"})
+		return
+	}
+
+	var req struct {
+		CurrentPassword string `json:"current_password" binding:"required"`
+		NewPassword     string `json:"new_password" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.passwordService.ChangePassword(user, req.CurrentPassword, req.NewPassword); err != nil {
+		handlePasswordError(c, err)
+		return
+	}
+
+	tokens, err := h.authService.IssueTokens(user, sessionInfo(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "senha alterada, mas houve erro ao gerar novo token; faça login novamente"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":            "senha alterada com sucesso",
+		"token":              tokens.AccessToken,
+		"token_type":         tokens.TokenType,
+		"expires_at":         tokens.ExpiresAt,
+		"refresh_token":      tokens.RefreshToken,
+		"refresh_expires_at": tokens.RefreshExpiresAt,
+	})
+}
+
+// ResetByAdmin gera uma senha temporária que o usuário terá de trocar no próximo acesso
+func (h *PasswordHandler) ResetByAdmin(c *gin.Context) {
+	password, err := h.passwordService.ResetByAdmin(c.Param("id"))
+	if err != nil {
+		handlePasswordError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":            "senha temporária gerada; o usuário deverá trocá-la no próximo acesso",
+		"temporary_password": password,
+	})
+}
+
+func handlePasswordError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repositories.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrInvalidResetToken), errors.Is(err, repositories.ErrInvalidID),
+		services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrWrongPassword), errors.Is(err, services.ErrUserInactive):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
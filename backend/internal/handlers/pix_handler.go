@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/pix"
+	"github.com/jurisconnect/backend/internal/services"
+)
+
+// maxPixStatementSize limita o tamanho dos extratos de Pix enviados para conciliação
+const maxPixStatementSize = 5 << 20
+
+type PixHandler struct {
+	pixService *services.PixService
+}
+
+func NewPixHandler(pixService *services.PixService) *PixHandler {
+	return &PixHandler{pixService: pixService}
+}
+
+// Charge retorna o BR Code "copia e cola" da fatura
+func (h *PixHandler) Charge(c *gin.Context) {
+	charge, err := h.pixService.Charge(c.Param("id"))
+	if err != nil {
+		handlePixError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, charge)
+}
+
+// QRCode retorna o PNG do QR Code da fatura; ?size= define o lado em pixels
+func (h *PixHandler) QRCode(c *gin.Context) {
+	size := pix.DefaultQRCodeSize
+	if value := c.Query("size"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < pix.MinQRCodeSize || parsed > pix.MaxQRCodeSize {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("tamanho deve estar entre %d e %d pixels", pix.MinQRCodeSize, pix.MaxQRCodeSize)})
+			return
+		}
+		size = parsed
+	}
+
+	image, err := h.pixService.QRCode(c.Param("id"), size)
+	if err != nil {
+		handlePixError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, pix.PNGContentType, image)
+}
+
+// Reconcile recebe no campo "file" o extrato de Pix recebidos (JSON da API
+// Pix ou CSV) e dá baixa nas faturas pelo txid
+func (h *PixHandler) Reconcile(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxPixStatementSize)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("arquivo excede o limite de %d bytes", maxPixStatementSize)})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "arquivo não enviado no campo \"file\""})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "erro ao ler arquivo enviado"})
+		return
+	}
+	defer file.Close()
+
+	result, err := h.pixService.Reconcile(file)
+	if err != nil {
+		handlePixError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func handlePixError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrPixNotConfigured):
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+	default:
+		handleInvoiceError(c, err)
+	}
+}
@@ -0,0 +1,348 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type TaskHandler struct {
+	taskService *services.TaskService
+}
+
+func NewTaskHandler(taskService *services.TaskService) *TaskHandler {
+	return &TaskHandler{taskService: taskService}
+}
+
+// ChecklistItemRequest descreve um item do checklist; itens com ID mantêm
+// o histórico de conclusão
+type ChecklistItemRequest struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
+
+// CreateTaskRequest recebe o vencimento no formato AAAA-MM-DD
+type CreateTaskRequest struct {
+	Title       string                 `json:"title" binding:"required"`
+	Description string                 `json:"description"`
+	AssigneeID  string                 `json:"assignee_id"`
+	CaseID      string                 `json:"case_id"`
+	Priority    string                 `json:"priority"`
+	DueDate     string                 `json:"due_date"`
+	Checklist   []ChecklistItemRequest `json:"checklist"`
+}
+
+type UpdateTaskRequest struct {
+	Title       string                 `json:"title"`
+	Description string                 `json:"description"`
+	AssigneeID  string                 `json:"assignee_id"`
+	CaseID      string                 `json:"case_id"`
+	Priority    string                 `json:"priority"`
+	DueDate     string                 `json:"due_date"`
+	Status      string                 `json:"status"`
+	Checklist   []ChecklistItemRequest `json:"checklist"`
+}
+
+type UpdateChecklistItemRequest struct {
+	Text string `json:"text"`
+	Done *bool  `json:"done"`
+}
+
+type TaskCommentRequest struct {
+	Text string `json:"text" binding:"required"`
+}
+
+// toChecklist converte os itens recebidos, preservando a conclusão dos itens já existentes
+func toChecklist(items []ChecklistItemRequest, current []domain.ChecklistItem) ([]domain.ChecklistItem, error) {
+	existing := make(map[primitive.ObjectID]domain.ChecklistItem, len(current))
+	for _, item := range current {
+		existing[item.ID] = item
+	}
+
+	checklist := make([]domain.ChecklistItem, 0, len(items))
+	for _, req := range items {
+		item := domain.ChecklistItem{Text: req.Text, Done: req.Done}
+		if req.ID != "" {
+			id, err := primitive.ObjectIDFromHex(req.ID)
+			if err != nil {
+				return nil, errors.New("ID de item do checklist inválido")
+			}
+			item.ID = id
+			if previous, ok := existing[id]; ok && previous.Done && req.Done {
+				item.DoneBy = previous.DoneBy
+				item.DoneAt = previous.DoneAt
+			}
+		}
+		checklist = append(checklist, item)
+	}
+	return checklist, nil
+}
+
+func parseDueDate(value string) (*time.Time, error) {
+	dueDate, err := calendar.ParseDate(value)
+	if err != nil {
+		return nil, err
+	}
+	return &dueDate, nil
+}
+
+func (h *TaskHandler) Create(c *gin.Context) {
+	var req CreateTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	task := &domain.Task{
+		Title:       req.Title,
+		Description: req.Description,
+		Priority:    domain.TaskPriority(req.Priority),
+		CreatedBy:   user.ID,
+	}
+
+	// Sem responsável informado, a tarefa fica com o usuário autenticado
+	task.AssigneeID = user.ID
+	var err error
+	if req.AssigneeID != "" {
+		task.AssigneeID, err = primitive.ObjectIDFromHex(req.AssigneeID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do responsável inválido"})
+			return
+		}
+	}
+	if req.CaseID != "" {
+		task.CaseID, err = primitive.ObjectIDFromHex(req.CaseID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do processo inválido"})
+			return
+		}
+	}
+	if req.DueDate != "" {
+		task.DueDate, err = parseDueDate(req.DueDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	task.Checklist, err = toChecklist(req.Checklist, nil)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.taskService.Create(task); err != nil {
+		handleTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, task)
+}
+
+// List aceita os filtros ?assignee_id=, ?case_id=, ?status=, ?priority= e ?created_by=
+func (h *TaskHandler) List(c *gin.Context) {
+	query := domain.TaskQuery{
+		CreatedBy: c.Query("created_by"),
+		CaseID:    c.Query("case_id"),
+		Status:    domain.TaskStatus(c.Query("status")),
+		Priority:  domain.TaskPriority(c.Query("priority")),
+	}
+	if assigneeID := c.Query("assignee_id"); assigneeID != "" {
+		objectID, err := primitive.ObjectIDFromHex(assigneeID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do responsável inválido"})
+			return
+		}
+		query.AssigneeIDs = []primitive.ObjectID{objectID}
+	}
+
+	tasks, err := h.taskService.List(query)
+	if err != nil {
+		handleTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tasks)
+}
+
+// GetMine lista as tarefas do usuário autenticado, com filtro opcional ?status=
+func (h *TaskHandler) GetMine(c *gin.Context) {
+	user, _ := middleware.CurrentUser(c)
+	tasks, err := h.taskService.GetMine(user.ID.Hex(), domain.TaskStatus(c.Query("status")))
+	if err != nil {
+		handleTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tasks)
+}
+
+// GetDelegated lista as tarefas que o usuário autenticado delegou aos seus subordinados
+func (h *TaskHandler) GetDelegated(c *gin.Context) {
+	user, _ := middleware.CurrentUser(c)
+	tasks, err := h.taskService.GetDelegated(user.ID.Hex(), domain.TaskStatus(c.Query("status")))
+	if err != nil {
+		handleTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tasks)
+}
+
+// GetOverdue lista as tarefas vencidas de ?assignee_id= ou, sem o filtro,
+// do usuário autenticado; ?assignee_id=all considera todos os usuários
+func (h *TaskHandler) GetOverdue(c *gin.Context) {
+	assigneeID := c.Query("assignee_id")
+	switch assigneeID {
+	case "":
+		user, _ := middleware.CurrentUser(c)
+		assigneeID = user.ID.Hex()
+	case "all":
+		assigneeID = ""
+	}
+
+	tasks, err := h.taskService.GetOverdue(assigneeID)
+	if err != nil {
+		handleTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tasks)
+}
+
+func (h *TaskHandler) GetByID(c *gin.Context) {
+	task, err := h.taskService.GetByID(c.Param("id"))
+	if err != nil {
+		handleTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, task)
+}
+
+func (h *TaskHandler) Update(c *gin.Context) {
+	var req UpdateTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	task, err := h.taskService.GetByID(c.Param("id"))
+	if err != nil {
+		handleTaskError(c, err)
+		return
+	}
+
+	if req.Title != "" {
+		task.Title = req.Title
+	}
+	if req.Description != "" {
+		task.Description = req.Description
+	}
+	if req.Priority != "" {
+		task.Priority = domain.TaskPriority(req.Priority)
+	}
+	if req.Status != "" {
+		task.Status = domain.TaskStatus(req.Status)
+	}
+	if req.AssigneeID != "" {
+		task.AssigneeID, err = primitive.ObjectIDFromHex(req.AssigneeID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do responsável inválido"})
+			return
+		}
+	}
+	if req.CaseID != "" {
+		task.CaseID, err = primitive.ObjectIDFromHex(req.CaseID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do processo inválido"})
+			return
+		}
+	}
+	if req.DueDate != "" {
+		task.DueDate, err = parseDueDate(req.DueDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	// Listas enviadas substituem as atuais; ausentes mantêm as existentes
+	if req.Checklist != nil {
+		task.Checklist, err = toChecklist(req.Checklist, task.Checklist)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	if err := h.taskService.Update(task, user.ID); err != nil {
+		handleTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, task)
+}
+
+func (h *TaskHandler) UpdateChecklistItem(c *gin.Context) {
+	var req UpdateChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	task, err := h.taskService.SetChecklistItem(c.Param("id"), c.Param("itemId"), req.Done, req.Text, user.ID)
+	if err != nil {
+		handleTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, task)
+}
+
+func (h *TaskHandler) AddComment(c *gin.Context) {
+	var req TaskCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	comment := &domain.TaskComment{AuthorID: user.ID, Text: req.Text}
+	if err := h.taskService.AddComment(c.Param("id"), comment); err != nil {
+		handleTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, comment)
+}
+
+func (h *TaskHandler) Delete(c *gin.Context) {
+	if err := h.taskService.Delete(c.Param("id")); err != nil {
+		handleTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "tarefa removida com sucesso"})
+}
+
+func handleTaskError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repositories.ErrTaskNotFound), errors.Is(err, services.ErrChecklistItemNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
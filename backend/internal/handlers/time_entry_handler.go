@@ -0,0 +1,341 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/middleware"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type TimeEntryHandler struct {
+	entryService *services.TimeEntryService
+}
+
+func NewTimeEntryHandler(entryService *services.TimeEntryService) *TimeEntryHandler {
+	return &TimeEntryHandler{entryService: entryService}
+}
+
+// CreateTimeEntryRequest aceita início e fim (RFC 3339) ou a data
+// (AAAA-MM-DD) com a duração em minutos. Sem billable, o lançamento é faturável.
+type CreateTimeEntryRequest struct {
+	CaseID       string     `json:"case_id" binding:"required"`
+	UserID       string     `json:"user_id"`
+	Date         string     `json:"date"`
+	StartAt      *time.Time `json:"start_at"`
+	EndAt        *time.Time `json:"end_at"`
+	Minutes      int        `json:"minutes"`
+	ActivityCode string     `json:"activity_code"`
+	Description  string     `json:"description"`
+	Billable     *bool      `json:"billable"`
+}
+
+type UpdateTimeEntryRequest struct {
+	CaseID       string     `json:"case_id"`
+	Date         string     `json:"date"`
+	StartAt      *time.Time `json:"start_at"`
+	EndAt        *time.Time `json:"end_at"`
+	Minutes      *int       `json:"minutes"`
+	ActivityCode string     `json:"activity_code"`
+	Description  string     `json:"description"`
+	Billable     *bool      `json:"billable"`
+}
+
+type StartTimerRequest struct {
+	CaseID       string `json:"case_id" binding:"required"`
+	ActivityCode string `json:"activity_code"`
+	Description  string `json:"description"`
+	Billable     *bool  `json:"billable"`
+}
+
+func (h *TimeEntryHandler) Create(c *gin.Context) {
+	var req CreateTimeEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	caseID, err := primitive.ObjectIDFromHex(req.CaseID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do processo inválido"})
+		return
+	}
+
+	// Sem usuário informado, as horas são lançadas para o usuário autenticado
+	user, _ := middleware.CurrentUser(c)
+	userID := user.ID
+	if req.UserID != "" {
+		userID, err = primitive.ObjectIDFromHex(req.UserID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do usuário inválido"})
+			return
+		}
+	}
+
+	entry := &domain.TimeEntry{
+		UserID:       userID,
+		CaseID:       caseID,
+		StartAt:      req.StartAt,
+		EndAt:        req.EndAt,
+		Minutes:      req.Minutes,
+		ActivityCode: domain.ActivityCode(req.ActivityCode),
+		Description:  req.Description,
+		Billable:     req.Billable == nil || *req.Billable,
+	}
+	if req.Date != "" {
+		entry.Date, err = calendar.ParseDate(req.Date)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if err := h.entryService.Create(entry); err != nil {
+		handleTimeEntryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, entry)
+}
+
+// List aceita os filtros ?user_id=, ?case_id=, ?from= e ?to= (AAAA-MM-DD,
+// com to inclusivo) e ?billable=
+func (h *TimeEntryHandler) List(c *gin.Context) {
+	query := domain.TimeEntryQuery{
+		UserID: c.Query("user_id"),
+		CaseID: c.Query("case_id"),
+	}
+
+	var err error
+	if from := c.Query("from"); from != "" {
+		if query.From, err = calendar.ParseDate(from); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if to := c.Query("to"); to != "" {
+		if query.To, err = calendar.ParseDate(to); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		query.To = query.To.AddDate(0, 0, 1)
+	}
+	if billable := c.Query("billable"); billable != "" {
+		value, err := strconv.ParseBool(billable)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "filtro billable inválido"})
+			return
+		}
+		query.Billable = &value
+	}
+
+	entries, err := h.entryService.List(query)
+	if err != nil {
+		handleTimeEntryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+func (h *TimeEntryHandler) GetByID(c *gin.Context) {
+	entry, err := h.entryService.GetByID(c.Param("id"))
+	if err != nil {
+		handleTimeEntryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entry)
+}
+
+func (h *TimeEntryHandler) Update(c *gin.Context) {
+	var req UpdateTimeEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	entry, err := h.entryService.GetByID(c.Param("id"))
+	if err != nil {
+		handleTimeEntryError(c, err)
+		return
+	}
+
+	if req.CaseID != "" {
+		entry.CaseID, err = primitive.ObjectIDFromHex(req.CaseID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do processo inválido"})
+			return
+		}
+	}
+	if req.ActivityCode != "" {
+		entry.ActivityCode = domain.ActivityCode(req.ActivityCode)
+	}
+	if req.Description != "" {
+		entry.Description = req.Description
+	}
+	if req.Billable != nil {
+		entry.Billable = *req.Billable
+	}
+	// Informar a duração ou a data substitui o intervalo de início e fim
+	if req.Minutes != nil || req.Date != "" {
+		entry.StartAt = nil
+		entry.EndAt = nil
+		if req.Minutes != nil {
+			entry.Minutes = *req.Minutes
+		}
+		if req.Date != "" {
+			entry.Date, err = calendar.ParseDate(req.Date)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+	}
+	if req.StartAt != nil {
+		entry.StartAt = req.StartAt
+	}
+	if req.EndAt != nil {
+		entry.EndAt = req.EndAt
+	}
+
+	if err := h.entryService.Update(entry); err != nil {
+		handleTimeEntryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entry)
+}
+
+func (h *TimeEntryHandler) Delete(c *gin.Context) {
+	if err := h.entryService.Delete(c.Param("id")); err != nil {
+		handleTimeEntryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "lançamento removido com sucesso"})
+}
+
+// StartTimer inicia o cronômetro do usuário autenticado
+func (h *TimeEntryHandler) StartTimer(c *gin.Context) {
+	var req StartTimerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	caseID, err := primitive.ObjectIDFromHex(req.CaseID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID do processo inválido"})
+		return
+	}
+
+	user, _ := middleware.CurrentUser(c)
+	entry := &domain.TimeEntry{
+		UserID:       user.ID,
+		CaseID:       caseID,
+		ActivityCode: domain.ActivityCode(req.ActivityCode),
+		Description:  req.Description,
+		Billable:     req.Billable == nil || *req.Billable,
+	}
+
+	if err := h.entryService.StartTimer(entry); err != nil {
+		handleTimeEntryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, entry)
+}
+
+// StopTimer encerra o cronômetro do usuário autenticado
+func (h *TimeEntryHandler) StopTimer(c *gin.Context) {
+	user, _ := middleware.CurrentUser(c)
+	entry, err := h.entryService.StopTimer(user.ID.Hex())
+	if err != nil {
+		handleTimeEntryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entry)
+}
+
+// GetTimer retorna o cronômetro em andamento do usuário autenticado
+func (h *TimeEntryHandler) GetTimer(c *gin.Context) {
+	user, _ := middleware.CurrentUser(c)
+	entry, err := h.entryService.GetRunning(user.ID.Hex())
+	if err != nil {
+		handleTimeEntryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entry)
+}
+
+// parseWeek interpreta ?week= (qualquer dia da semana, AAAA-MM-DD); sem o
+// parâmetro, considera a semana atual
+func parseWeek(c *gin.Context) (time.Time, error) {
+	if week := c.Query("week"); week != "" {
+		return calendar.ParseDate(week)
+	}
+	return time.Now(), nil
+}
+
+// WeeklyByUser resume a semana de ?user_id= ou, sem o filtro, do usuário autenticado
+func (h *TimeEntryHandler) WeeklyByUser(c *gin.Context) {
+	week, err := parseWeek(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.Query("user_id")
+	if userID == "" {
+		user, _ := middleware.CurrentUser(c)
+		userID = user.ID.Hex()
+	}
+
+	summary, err := h.entryService.WeeklyByUser(userID, week)
+	if err != nil {
+		handleTimeEntryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+func (h *TimeEntryHandler) WeeklyByCase(c *gin.Context) {
+	week, err := parseWeek(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	summary, err := h.entryService.WeeklyByCase(c.Param("id"), week)
+	if err != nil {
+		handleTimeEntryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+func handleTimeEntryError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repositories.ErrTimeEntryNotFound), errors.Is(err, services.ErrNoRunningTimer):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrTimerAlreadyRunning), errors.Is(err, services.ErrTimerRunning),
+		errors.Is(err, services.ErrTimeEntryInvoiced):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
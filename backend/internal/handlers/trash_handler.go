@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/services"
+)
+
+type TrashHandler struct {
+	trashService *services.TrashService
+}
+
+func NewTrashHandler(trashService *services.TrashService) *TrashHandler {
+	return &TrashHandler{trashService: trashService}
+}
+
+// List aceita ?kind=users|cases|clients|documents para filtrar por tipo
+func (h *TrashHandler) List(c *gin.Context) {
+	items, err := h.trashService.List(domain.TrashKind(c.Query("kind")))
+	if err != nil {
+		handleTrashError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, items)
+}
+
+func (h *TrashHandler) Restore(c *gin.Context) {
+	if err := h.trashService.Restore(domain.TrashKind(c.Param("kind")), c.Param("id")); err != nil {
+		handleTrashError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "registro restaurado com sucesso"})
+}
+
+func (h *TrashHandler) Purge(c *gin.Context) {
+	if err := h.trashService.Purge(domain.TrashKind(c.Param("kind")), c.Param("id")); err != nil {
+		handleTrashError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "registro removido definitivamente"})
+}
+
+func handleTrashError(c *gin.Context, err error) {
+	var referencesErr *services.UserReferencesError
+	var recordReferencesErr *services.RecordReferencesError
+	switch {
+	case errors.As(err, &referencesErr):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "references": referencesErr.References})
+	case errors.As(err, &recordReferencesErr):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "references": recordReferencesErr.References})
+	case errors.Is(err, repositories.ErrUserNotFound), errors.Is(err, repositories.ErrCaseNotFound),
+		errors.Is(err, repositories.ErrClientNotFound), errors.Is(err, repositories.ErrDocumentNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrDuplicateEmail), errors.Is(err, repositories.ErrDuplicateCaseNumber),
+		errors.Is(err, repositories.ErrDuplicateClientDocument):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -1,11 +1,15 @@
 package handlers
 
 import (
+	"errors"
+	"log"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/middleware"
 	"github.com/jurisconnect/backend/internal/repositories"
 	"github.com/jurisconnect/backend/internal/security"
 	"github.com/jurisconnect/backend/internal/services"
@@ -13,11 +17,14 @@ import (
 )
 
 type UserHandler struct {
-	userService *services.UserService
+	userService      *services.UserService
+	authService      *services.AuthService
+	lifecycleService *services.UserLifecycleService
+	loginThrottle    *services.LoginThrottleService
 }
 
-func NewUserHandler(userService *services.UserService) *UserHandler {
-	return &UserHandler{userService: userService}
+func NewUserHandler(userService *services.UserService, authService *services.AuthService, lifecycleService *services.UserLifecycleService, loginThrottle *services.LoginThrottleService) *UserHandler {
+	return &UserHandler{userService: userService, authService: authService, lifecycleService: lifecycleService, loginThrottle: loginThrottle}
 }
 
 type CreateUserRequest struct {
@@ -39,14 +46,15 @@ type CreateUserRequest struct {
 		} `json:"address" binding:"required"`
 	} `json:"personal_info" binding:"required"`
 	ProfessionalInfo struct {
-		OABNumber    string   `json:"oab_number"`
-		OABState     string   `json:"oab_state"`
-		Specialties  []string `json:"specialties"`
-		HireDate     string   `json:"hire_date" binding:"required"`
-		Department   string   `json:"department" binding:"required"`
-		SupervisorID string   `json:"supervisor_id"`
+		OABNumber       string   `json:"oab_number"`
+		OABState        string   `json:"oab_state"`
+		Specialties     []string `json:"specialties"`
+		HireDate        string   `json:"hire_date" binding:"required"`
+		Department      string   `json:"department" binding:"required"`
+		SupervisorID    string   `json:"supervisor_id"`
+		HourlyRateCents int64    `json:"hourly_rate_cents" binding:"min=0"`
 	} `json:"professional_info" binding:"required"`
-	Role     string `json:"role" binding:"required,oneof=admin lawyer intern secretary"`
+	Role     string `json:"role" binding:"required"`
 	Password string `json:"password" binding:"required,min=6"`
 }
 
@@ -99,12 +107,13 @@ func (h *UserHandler) Create(c *gin.Context) {
 			},
 		},
 		ProfessionalInfo: domain.ProfessionalInfo{
-			OABNumber:    req.ProfessionalInfo.OABNumber,
-			OABState:     req.ProfessionalInfo.OABState,
-			Specialties:  req.ProfessionalInfo.Specialties,
-			HireDate:     hireDate,
-			Department:   req.ProfessionalInfo.Department,
-			SupervisorID: supervisorID,
+			OABNumber:       req.ProfessionalInfo.OABNumber,
+			OABState:        req.ProfessionalInfo.OABState,
+			Specialties:     req.ProfessionalInfo.Specialties,
+			HireDate:        hireDate,
+			Department:      req.ProfessionalInfo.Department,
+			SupervisorID:    supervisorID,
+			HourlyRateCents: req.ProfessionalInfo.HourlyRateCents,
 		},
 		Role:      req.Role,
 		Password:  req.Password,
@@ -114,6 +123,10 @@ func (h *UserHandler) Create(c *gin.Context) {
 	}
 
 	if err := h.userService.Create(user); err != nil {
+		if errors.Is(err, services.ErrInvalidRole) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -192,6 +205,20 @@ func (h *UserHandler) Update(c *gin.Context) {
 		return
 	}
 
+	// A edição do próprio perfil dispensa users:update, exceto para os campos administrativos
+	if req.ChangesAdministrativeFields() {
+		currentUser, _ := middleware.CurrentUser(c)
+		allowed, err := h.userService.RoleHasPermission(currentUser.Role, domain.ModuleUsers, domain.ActionUpdate)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "erro ao verificar permissões"})
+			return
+		}
+		if !allowed {
+			c.JSON(http.StatusForbidden, gin.H{"error": "departamento, data de contratação, supervisor e valor da hora só podem ser alterados por quem administra usuários"})
+			return
+		}
+	}
+
 	// Buscar usuário existente
 	existingUser, err := h.userService.GetByID(id)
 	if err != nil {
@@ -294,8 +321,23 @@ func (h *UserHandler) Update(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "ID do supervisor inválido"})
 			return
 		}
+		if supervisorID == existingUser.ID {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "o usuário não pode ser supervisor de si mesmo"})
+			return
+		}
+		if supervisor, err := h.userService.GetByID(supervisorID.Hex()); err != nil || !supervisor.IsActive {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "supervisor não encontrado ou desativado"})
+			return
+		}
 		existingUser.ProfessionalInfo.SupervisorID = supervisorID
 	}
+	if rate := req.ProfessionalInfo.HourlyRateCents; rate != nil {
+		if *rate < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "valor da hora não pode ser negativo"})
+			return
+		}
+		existingUser.ProfessionalInfo.HourlyRateCents = *rate
+	}
 
 	existingUser.UpdatedAt = time.Now()
 
@@ -307,18 +349,95 @@ func (h *UserHandler) Update(c *gin.Context) {
 	c.JSON(http.StatusOK, existingUser)
 }
 
+// DeleteUser remove definitivamente apenas usuários sem registros vinculados;
+// os demais devem ser desativados
 func (h *UserHandler) DeleteUser(c *gin.Context) {
-	id := c.Param("id")
-	if err := h.userService.Delete(id); err != nil {
-		if err == repositories.ErrUserNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "usuário não encontrado"})
+	currentUser, _ := middleware.CurrentUser(c)
+	if err := h.lifecycleService.Delete(c.Param("id"), currentUser); err != nil {
+		handleUserLifecycleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "usuário movido para a lixeira"})
+}
+
+// DeactivateUserRequest permite transferir o trabalho em aberto na desativação
+type DeactivateUserRequest struct {
+	ReassignTo string `json:"reassign_to"`
+}
+
+type ReassignUserRequest struct {
+	ToUserID string `json:"to_user_id" binding:"required"`
+}
+
+func (h *UserHandler) Deactivate(c *gin.Context) {
+	var req DeactivateUserRequest
+	// O corpo é opcional
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "erro ao deletar usuário"})
+	}
+
+	currentUser, _ := middleware.CurrentUser(c)
+	user, reassignment, err := h.lifecycleService.Deactivate(c.Param("id"), currentUser, req.ReassignTo)
+	if err != nil {
+		handleUserLifecycleError(c, err)
+		return
+	}
+
+	response := gin.H{"user": user}
+	if reassignment != nil {
+		response["reassignment"] = reassignment
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *UserHandler) Reactivate(c *gin.Context) {
+	user, err := h.lifecycleService.Reactivate(c.Param("id"))
+	if err != nil {
+		handleUserLifecycleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+// Reassign transfere processos em curso, tarefas abertas e prazos pendentes para outro usuário
+func (h *UserHandler) Reassign(c *gin.Context) {
+	var req ReassignUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reassignment, err := h.lifecycleService.Reassign(c.Param("id"), req.ToUserID)
+	if err != nil {
+		handleUserLifecycleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "usuário deletado com sucesso"})
+	c.JSON(http.StatusOK, reassignment)
+}
+
+func handleUserLifecycleError(c *gin.Context, err error) {
+	var referencesErr *services.UserReferencesError
+	switch {
+	case errors.As(err, &referencesErr):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "references": referencesErr.References})
+	case errors.Is(err, repositories.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrUserAlreadyInactive), errors.Is(err, services.ErrUserAlreadyActive),
+		errors.Is(err, services.ErrLastAdmin):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrSelfDeactivation):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID), services.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
 }
 
 func (h *UserHandler) Login(c *gin.Context) {
@@ -332,15 +451,34 @@ func (h *UserHandler) Login(c *gin.Context) {
 		return
 	}
 
+	ip := c.ClientIP()
+	if err := h.loginThrottle.Check(loginRequest.Email, ip); err != nil {
+		respondLoginLocked(c, err)
+		return
+	}
+
 	user, err := h.userService.GetByEmail(loginRequest.Email)
-	if err != nil {
+	validPassword := false
+	if err == nil {
+		validPassword = security.CheckPassword(loginRequest.Password, user.Password)
+	} else {
+		security.SimulatePasswordCheck(loginRequest.Password)
+	}
+	if !validPassword {
+		if err := h.loginThrottle.RecordFailure(loginRequest.Email, ip); err != nil {
+			log.Printf("Aviso: falha ao registrar tentativa de login: %v", err)
+		}
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "credenciais inválidas"})
 		return
 	}
 
-	// Verificar se a senha está correta
-	if !security.CheckPassword(loginRequest.Password, user.Password) {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "credenciais inválidas"})
+	if err := h.loginThrottle.RecordSuccess(loginRequest.Email); err != nil {
+		log.Printf("Aviso: falha ao zerar tentativas de login: %v", err)
+	}
+
+	// A situação da conta só é revelada a quem informou a senha correta
+	if !user.IsActive {
+		c.JSON(http.StatusForbidden, gin.H{"error": services.ErrUserInactive.Error()})
 		return
 	}
 
@@ -349,13 +487,82 @@ func (h *UserHandler) Login(c *gin.Context) {
 		return
 	}
 
+	// Emitir token de acesso e refresh token
+	tokens, err := h.authService.IssueTokens(user, sessionInfo(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "erro ao gerar token de acesso"})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"message": "login realizado com sucesso",
+		"message":            "login realizado com sucesso",
+		"token":              tokens.AccessToken,
+		"token_type":         tokens.TokenType,
+		"expires_at":         tokens.ExpiresAt,
+		"refresh_token":      tokens.RefreshToken,
+		"refresh_expires_at": tokens.RefreshExpiresAt,
 		"user": gin.H{
 			"id":    user.ID.Hex(),
 			"name":  user.PersonalInfo.Name,
 			"email": user.PersonalInfo.Email,
 			"role":  user.Role,
+			// O front-end deve levar à troca de senha antes de liberar o sistema
+			"must_change_password": user.MustChangePassword,
 		},
 	})
 }
+
+// respondLoginLocked responde 429 com o fim do bloqueio em Retry-After
+func respondLoginLocked(c *gin.Context, err error) {
+	var lockedErr *services.LoginLockedError
+	if !errors.As(err, &lockedErr) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "erro ao verificar tentativas de login"})
+		return
+	}
+
+	retryAfter := int(time.Until(lockedErr.Until).Seconds()) + 1
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error(), "locked_until": lockedErr.Until})
+}
+
+// Unlock desbloqueia o login do usuário bloqueado por excesso de falhas
+func (h *UserHandler) Unlock(c *gin.Context) {
+	if err := h.loginThrottle.UnlockUser(c.Param("id")); err != nil {
+		handleLoginLockError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "login do usuário desbloqueado"})
+}
+
+// GetLoginLocks lista as contas e IPs com login bloqueado no momento
+func (h *UserHandler) GetLoginLocks(c *gin.Context) {
+	locks, err := h.loginThrottle.GetLocked()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, locks)
+}
+
+// DeleteLoginLock desbloqueia uma conta ou IP pelo ID do bloqueio
+func (h *UserHandler) DeleteLoginLock(c *gin.Context) {
+	if err := h.loginThrottle.Unlock(c.Param("id")); err != nil {
+		handleLoginLockError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "bloqueio removido"})
+}
+
+func handleLoginLockError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repositories.ErrUserNotFound), errors.Is(err, repositories.ErrLoginThrottleNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, repositories.ErrInvalidID):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,18 @@
+package mail
+
+import (
+	"log"
+	"strings"
+)
+
+// LogMailer apenas registra os e-mails no log; útil em desenvolvimento
+type LogMailer struct{}
+
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+func (m *LogMailer) Send(msg Message) error {
+	log.Printf("E-mail para %s: %s\n%s", strings.Join(msg.To, ", "), msg.Subject, msg.Body)
+	return nil
+}
@@ -0,0 +1,32 @@
+package mail
+
+import (
+	"fmt"
+
+	"github.com/jurisconnect/backend/internal/config"
+)
+
+// Message é um e-mail em texto simples
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Mailer abstrai o envio dos e-mails do sistema (avisos de segurança,
+// redefinição de senha)
+type Mailer interface {
+	Send(msg Message) error
+}
+
+// New cria o mailer configurado em MAIL_DRIVER
+func New(cfg *config.Config) (Mailer, error) {
+	switch cfg.Mail.Driver {
+	case "log":
+		return NewLogMailer(), nil
+	case "smtp":
+		return NewSMTPMailer(cfg.Mail)
+	default:
+		return nil, fmt.Errorf("driver de e-mail desconhecido: %s", cfg.Mail.Driver)
+	}
+}
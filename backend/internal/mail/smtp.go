@@ -0,0 +1,63 @@
+package mail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/config"
+)
+
+// SMTPMailer envia os e-mails por um servidor SMTP, com STARTTLS quando
+// oferecido pelo servidor
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPMailer(cfg config.MailConfig) (*SMTPMailer, error) {
+	if cfg.SMTP.Host == "" {
+		return nil, errors.New("SMTP_HOST é obrigatório para o driver smtp")
+	}
+	if cfg.From == "" {
+		return nil, errors.New("MAIL_FROM é obrigatório para o driver smtp")
+	}
+
+	mailer := &SMTPMailer{
+		addr: net.JoinHostPort(cfg.SMTP.Host, cfg.SMTP.Port),
+		from: cfg.From,
+	}
+	if cfg.SMTP.Username != "" {
+		mailer.auth = smtp.PlainAuth("", cfg.SMTP.Username, cfg.SMTP.Password, cfg.SMTP.Host)
+	}
+	return mailer, nil
+}
+
+func (m *SMTPMailer) Send(msg Message) error {
+	if len(msg.To) == 0 {
+		return errors.New("e-mail sem destinatários")
+	}
+	for _, address := range append([]string{m.from}, msg.To...) {
+		if strings.ContainsAny(address, "\r\n") {
+			return fmt.Errorf("endereço de e-mail inválido: %q", address)
+		}
+	}
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "From: %s\r\n", m.from)
+	fmt.Fprintf(&body, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&body, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&body, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	body.WriteString("MIME-Version: 1.0\r\n")
+	body.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	body.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	body.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	return smtp.SendMail(m.addr, m.auth, m.from, msg.To, body.Bytes())
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/security"
+	"github.com/jurisconnect/backend/internal/services"
+)
+
+const (
+	// ContextUserKey é a chave do usuário autenticado no contexto da requisição
+	ContextUserKey = "user"
+
+	// ContextClaimsKey é a chave das claims do token no contexto da requisição
+	ContextClaimsKey = "claims"
+)
+
+// AuthMiddleware valida o header "Authorization: Bearer <token>" e
+// disponibiliza o usuário autenticado no contexto da requisição
+func AuthMiddleware(tokens *security.TokenManager, userService *services.UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if header == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "token de autenticação ausente"})
+			return
+		}
+
+		parts := strings.SplitN(header, " ", 2)
+		if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") || strings.TrimSpace(parts[1]) == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "formato do header Authorization inválido"})
+			return
+		}
+
+		claims, err := tokens.Parse(strings.TrimSpace(parts[1]))
+		if err != nil {
+			if errors.Is(err, security.ErrExpiredToken) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "token expirado"})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "token inválido"})
+			return
+		}
+
+		// Carregar o usuário para refletir alterações feitas após a emissão do token
+		user, err := userService.GetByID(claims.UserID)
+		if err != nil || user == nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "usuário do token não encontrado"})
+			return
+		}
+
+		if !user.IsActive {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": services.ErrUserInactive.Error()})
+			return
+		}
+
+		// Tokens emitidos antes de um "encerrar todas as sessões" deixam de valer
+		if claims.Generation != user.TokenGeneration {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "sessão encerrada"})
+			return
+		}
+
+		c.Set(ContextClaimsKey, claims)
+		c.Set(ContextUserKey, user)
+		c.Next()
+	}
+}
+
+// CurrentUser retorna o usuário autenticado pela AuthMiddleware
+func CurrentUser(c *gin.Context) (*domain.User, bool) {
+	value, exists := c.Get(ContextUserKey)
+	if !exists {
+		return nil, false
+	}
+	user, ok := value.(*domain.User)
+	return user, ok
+}
+
+// RequirePasswordChanged bloqueia o uso do sistema enquanto o usuário não
+// trocar a senha temporária definida por um administrador
+func RequirePasswordChanged() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if user, ok := CurrentUser(c); ok && user.MustChangePassword {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error":                services.ErrPasswordChangeRequired.Error(),
+				"must_change_password": true,
+			})
+			return
+		}
+		c.Next()
+	}
+}
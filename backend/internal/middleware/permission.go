@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/services"
+)
+
+// Códigos de erro retornados pelas respostas de autorização
+const (
+	ErrCodeUnauthenticated  = "UNAUTHENTICATED"
+	ErrCodePermissionDenied = "PERMISSION_DENIED"
+	ErrCodeAuthzFailure     = "AUTHORIZATION_ERROR"
+)
+
+// Authorizer avalia as permissões das roles do usuário autenticado
+type Authorizer struct {
+	userService *services.UserService
+}
+
+func NewAuthorizer(userService *services.UserService) *Authorizer {
+	return &Authorizer{userService: userService}
+}
+
+// RequirePermission exige que a role do usuário autenticado conceda a ação sobre o módulo
+func (a *Authorizer) RequirePermission(module, action string) gin.HandlerFunc {
+	return a.require(module, action, "")
+}
+
+// RequirePermissionOrSelf funciona como RequirePermission, mas também libera o
+// acesso quando o parâmetro de rota informado é o ID do próprio usuário
+func (a *Authorizer) RequirePermissionOrSelf(module, action, idParam string) gin.HandlerFunc {
+	return a.require(module, action, idParam)
+}
+
+func (a *Authorizer) require(module, action, selfParam string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := CurrentUser(c)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": "usuário não autenticado",
+				"code":  ErrCodeUnauthenticated,
+			})
+			return
+		}
+
+		if selfParam != "" && c.Param(selfParam) == user.ID.Hex() {
+			c.Next()
+			return
+		}
+
+		allowed, err := a.userService.RoleHasPermission(user.Role, module, action)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": "erro ao verificar permissões",
+				"code":  ErrCodeAuthzFailure,
+			})
+			return
+		}
+		if !allowed {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error":  "permissão negada",
+				"code":   ErrCodePermissionDenied,
+				"module": module,
+				"action": action,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
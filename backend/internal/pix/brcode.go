@@ -0,0 +1,213 @@
+package pix
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/jurisconnect/backend/internal/validation"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Identificadores (ID) dos campos do BR Code (padrão EMV QRCPS-MPM, Manual
+// de Padrões para Iniciação do Pix do Banco Central)
+const (
+	idPayloadFormat       = "00"
+	idMerchantAccount     = "26"
+	idMerchantCategory    = "52"
+	idTransactionCurrency = "53"
+	idTransactionAmount   = "54"
+	idCountryCode         = "58"
+	idMerchantName        = "59"
+	idMerchantCity        = "60"
+	idAdditionalData      = "62"
+	idCRC16               = "63"
+	idAccountGUI          = "00"
+	idAccountKey          = "01"
+	idAccountInfo         = "02"
+	idAdditionalDataTxID  = "05"
+	pixGUI                = "br.gov.bcb.pix"
+	currencyBRL           = "986"
+)
+
+// Limites de tamanho dos campos definidos pelo manual do Pix
+const (
+	maxMerchantNameLength = 25
+	maxMerchantCityLength = 15
+	maxTxIDLength         = 25
+	maxKeyLength          = 77
+	maxFieldLength        = 99
+)
+
+// staticTxID é o txid usado quando o QR Code estático não identifica a cobrança
+const staticTxID = "***"
+
+var (
+	// ErrInvalidKey é retornado quando a chave Pix não está em um formato reconhecido
+	ErrInvalidKey = errors.New("chave Pix inválida")
+
+	// ErrInvalidTxID é retornado quando o txid não é alfanumérico ou excede 25 caracteres
+	ErrInvalidTxID = errors.New("txid inválido: use até 25 letras e números")
+
+	txIDPattern  = regexp.MustCompile(`^[A-Za-z0-9]{1,25}$`)
+	emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	phonePattern = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+	evpPattern   = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+)
+
+// Merchant identifica o recebedor: a chave Pix do escritório, o nome e a
+// cidade exibidos no aplicativo do pagador
+type Merchant struct {
+	Key  string
+	Name string
+	City string
+}
+
+// Payload são os dados de uma cobrança Pix. Apenas o BR Code estático é
+// suportado, com a chave do recebedor e o valor embutidos; o dinâmico exigiria
+// criar a cobrança na API do PSP do escritório, que o sistema não integra.
+type Payload struct {
+	Merchant
+	AmountCents int64
+	TxID        string
+	Description string
+}
+
+// ValidateKey verifica se a chave é um CPF, CNPJ, e-mail, telefone no formato
+// internacional (+55...) ou chave aleatória (EVP)
+func ValidateKey(key string) error {
+	switch {
+	case key == "", len(key) > maxKeyLength:
+		return ErrInvalidKey
+	case validation.OnlyDigits(key) == key && (len(key) == 11 || len(key) == 14):
+		if !validation.IsValidCPFOrCNPJ(key) {
+			return ErrInvalidKey
+		}
+	case strings.HasPrefix(key, "+"):
+		if !phonePattern.MatchString(key) {
+			return ErrInvalidKey
+		}
+	case strings.Contains(key, "@"):
+		if !emailPattern.MatchString(key) {
+			return ErrInvalidKey
+		}
+	case !evpPattern.MatchString(strings.ToLower(key)):
+		return ErrInvalidKey
+	}
+	return nil
+}
+
+// ValidateTxID verifica o identificador da transação (até 25 caracteres alfanuméricos)
+func ValidateTxID(txid string) error {
+	if !txIDPattern.MatchString(txid) {
+		return ErrInvalidTxID
+	}
+	return nil
+}
+
+// Encode gera o BR Code "copia e cola" com o CRC16 ao final
+func (p Payload) Encode() (string, error) {
+	if p.AmountCents < 0 {
+		return "", errors.New("valor da cobrança não pode ser negativo")
+	}
+	name := sanitize(p.Name, maxMerchantNameLength)
+	city := sanitize(p.City, maxMerchantCityLength)
+	if name == "" || city == "" {
+		return "", errors.New("nome e cidade do recebedor são obrigatórios")
+	}
+
+	if err := ValidateKey(p.Key); err != nil {
+		return "", err
+	}
+	account := field(idAccountGUI, pixGUI) + field(idAccountKey, p.Key)
+	if description := sanitize(p.Description, maxFieldLength); description != "" {
+		// A descrição é opcional e cede espaço à chave
+		room := maxFieldLength - len(account) - 4
+		if room > 0 {
+			account += field(idAccountInfo, truncate(description, room))
+		}
+	}
+	txid := p.TxID
+	if txid == "" {
+		txid = staticTxID
+	} else if err := ValidateTxID(txid); err != nil {
+		return "", err
+	}
+	if len(account) > maxFieldLength {
+		return "", errors.New("dados da conta do recebedor excedem o tamanho do BR Code")
+	}
+
+	var b strings.Builder
+	b.WriteString(field(idPayloadFormat, "01"))
+	b.WriteString(field(idMerchantAccount, account))
+	b.WriteString(field(idMerchantCategory, "0000"))
+	b.WriteString(field(idTransactionCurrency, currencyBRL))
+	if p.AmountCents > 0 {
+		b.WriteString(field(idTransactionAmount, FormatAmount(p.AmountCents)))
+	}
+	b.WriteString(field(idCountryCode, "BR"))
+	b.WriteString(field(idMerchantName, name))
+	b.WriteString(field(idMerchantCity, city))
+	b.WriteString(field(idAdditionalData, field(idAdditionalDataTxID, txid)))
+
+	// O CRC é calculado sobre todo o payload, incluindo o ID e o tamanho do próprio campo
+	b.WriteString(idCRC16 + "04")
+	b.WriteString(CRC16(b.String()))
+	return b.String(), nil
+}
+
+// FormatAmount formata centavos com ponto decimal, como exigido no campo 54 ("1234.50")
+func FormatAmount(cents int64) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
+
+// CRC16 calcula o CRC-16/CCITT-FALSE (polinômio 0x1021, valor inicial
+// 0xFFFF) em hexadecimal maiúsculo com 4 dígitos
+func CRC16(data string) string {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(data); i++ {
+		crc ^= uint16(data[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return fmt.Sprintf("%04X", crc)
+}
+
+// field codifica um campo EMV no formato ID + tamanho com 2 dígitos + valor
+func field(id, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(value), value)
+}
+
+// sanitize remove acentos e caracteres fora do ASCII imprimível, que nem
+// todos os aplicativos bancários aceitam, e limita o tamanho do texto
+func sanitize(value string, limit int) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	result, _, err := transform.String(t, value)
+	if err != nil {
+		result = value
+	}
+
+	var b strings.Builder
+	for _, r := range result {
+		if r >= 0x20 && r < 0x7F {
+			b.WriteRune(r)
+		}
+	}
+	return truncate(strings.Join(strings.Fields(b.String()), " "), limit)
+}
+
+func truncate(value string, limit int) string {
+	if len(value) > limit {
+		return strings.TrimSpace(value[:limit])
+	}
+	return value
+}
@@ -0,0 +1,93 @@
+package pix
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCRC16(t *testing.T) {
+	tests := map[string]string{
+		// Valor de verificação do CRC-16/CCITT-FALSE
+		"123456789": "29B1",
+		// Exemplo do Manual de Padrões para Iniciação do Pix (BCB)
+		"00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***6304": "1D3D",
+	}
+
+	for data, want := range tests {
+		if got := CRC16(data); got != want {
+			t.Errorf("CRC16(%q) = %s, esperado %s", data, got, want)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload Payload
+		want    string
+	}{
+		{
+			name: "exemplo do BCB",
+			payload: Payload{
+				Merchant: Merchant{Key: "123e4567-e12b-12d1-a456-426655440000", Name: "Fulano de Tal", City: "BRASILIA"},
+			},
+			want: "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D",
+		},
+		{
+			name: "fatura com valor, descrição e txid",
+			payload: Payload{
+				Merchant:    Merchant{Key: "12345678909", Name: "Escritório São João", City: "São Paulo"},
+				AmountCents: 123450,
+				TxID:        "JC2026000123",
+				Description: "Fatura 2026/000123",
+			},
+			want: "00020126550014br.gov.bcb.pix0111123456789090218Fatura 2026/00012352040000530398654071234.505802BR5919Escritorio Sao Joao6009Sao Paulo62160512JC20260001236304E136",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.payload.Encode()
+			if err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Encode =\n%s\nesperado\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeInvalid(t *testing.T) {
+	merchant := Merchant{Key: "12345678909", Name: "Escritorio", City: "Sao Paulo"}
+
+	if _, err := (Payload{Merchant: Merchant{Key: "12345678900", Name: "Escritorio", City: "Sao Paulo"}}).Encode(); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("CPF com dígito errado: %v, esperado ErrInvalidKey", err)
+	}
+	if _, err := (Payload{Merchant: merchant, TxID: "JC-2026"}).Encode(); !errors.Is(err, ErrInvalidTxID) {
+		t.Errorf("txid com hífen: %v, esperado ErrInvalidTxID", err)
+	}
+	if _, err := (Payload{Merchant: merchant, AmountCents: -1}).Encode(); err == nil {
+		t.Error("valor negativo deve ser rejeitado")
+	}
+}
+
+func TestValidateKey(t *testing.T) {
+	tests := map[string]bool{
+		"12345678909":                          true,
+		"00000000000191":                       true,
+		"financeiro@escritorio.com.br":         true,
+		"+5511987654321":                       true,
+		"123e4567-e12b-12d1-a456-426655440000": true,
+		"11987654321":                          false,
+		"financeiro@":                          false,
+		"+55":                                  false,
+		"":                                     false,
+	}
+
+	for key, valid := range tests {
+		if err := ValidateKey(key); (err == nil) != valid {
+			t.Errorf("ValidateKey(%q) = %v, esperado válido = %v", key, err, valid)
+		}
+	}
+}
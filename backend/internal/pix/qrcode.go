@@ -0,0 +1,22 @@
+package pix
+
+import (
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// PNGContentType é o tipo MIME da imagem do QR Code
+const PNGContentType = "image/png"
+
+// Limites do lado da imagem do QR Code, em pixels
+const (
+	DefaultQRCodeSize = 256
+	MinQRCodeSize     = 128
+	MaxQRCodeSize     = 1024
+)
+
+// QRCode gera a imagem PNG do BR Code com correção de erros média, a
+// recomendada pelo manual do Pix para leitura em telas e impressos
+func QRCode(brcode string, size int) ([]byte, error) {
+	size = min(max(size, MinQRCodeSize), MaxQRCodeSize)
+	return qrcode.Encode(brcode, qrcode.Medium, size)
+}
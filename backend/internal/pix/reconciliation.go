@@ -0,0 +1,201 @@
+package pix
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Credit é um Pix recebido, lido do extrato do banco ou PSP
+type Credit struct {
+	TxID        string    `json:"txid"`
+	EndToEndID  string    `json:"end_to_end_id,omitempty"`
+	AmountCents int64     `json:"amount_cents"`
+	PaidAt      time.Time `json:"paid_at"`
+}
+
+// ParseStatement lê os Pix recebidos de um extrato. Aceita o JSON da API Pix
+// do Banco Central (GET /pix: {"pix": [{"endToEndId", "txid", "valor",
+// "horario"}]}) ou um CSV com cabeçalho contendo as colunas txid, valor (ou
+// amount) e data (ou date), e opcionalmente end_to_end_id. Valores aceitam
+// "1234.56" ou "1.234,56"; datas, RFC 3339, AAAA-MM-DD ou DD/MM/AAAA.
+func ParseStatement(r io.Reader) ([]Credit, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	content = bytes.TrimPrefix(content, []byte("\ufeff"))
+
+	trimmed := bytes.TrimSpace(content)
+	if len(trimmed) == 0 {
+		return nil, errors.New("extrato vazio")
+	}
+	if trimmed[0] == '{' || trimmed[0] == '[' {
+		return parseJSONStatement(trimmed)
+	}
+	return parseCSVStatement(string(content))
+}
+
+type apiPix struct {
+	EndToEndID string `json:"endToEndId"`
+	TxID       string `json:"txid"`
+	Valor      string `json:"valor"`
+	Horario    string `json:"horario"`
+}
+
+func parseJSONStatement(content []byte) ([]Credit, error) {
+	var items []apiPix
+	if content[0] == '[' {
+		if err := json.Unmarshal(content, &items); err != nil {
+			return nil, fmt.Errorf("JSON do extrato inválido: %v", err)
+		}
+	} else {
+		var envelope struct {
+			Pix []apiPix `json:"pix"`
+		}
+		if err := json.Unmarshal(content, &envelope); err != nil {
+			return nil, fmt.Errorf("JSON do extrato inválido: %v", err)
+		}
+		items = envelope.Pix
+	}
+
+	credits := make([]Credit, 0, len(items))
+	for i, item := range items {
+		credit, err := newCredit(item.TxID, item.EndToEndID, item.Valor, item.Horario)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %v", i+1, err)
+		}
+		credits = append(credits, credit)
+	}
+	return credits, nil
+}
+
+func parseCSVStatement(text string) ([]Credit, error) {
+	reader := csv.NewReader(strings.NewReader(text))
+	firstLine, _, _ := strings.Cut(text, "\n")
+	if strings.Count(firstLine, ";") > strings.Count(firstLine, ",") {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int)
+	for i, header := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(header))] = i
+	}
+	column := func(names ...string) (int, bool) {
+		for _, name := range names {
+			if i, ok := columns[name]; ok {
+				return i, true
+			}
+		}
+		return 0, false
+	}
+	txidColumn, ok := column("txid")
+	if !ok {
+		return nil, errors.New("coluna \"txid\" ausente no CSV")
+	}
+	amountColumn, ok := column("valor", "amount")
+	if !ok {
+		return nil, errors.New("coluna \"valor\" ausente no CSV")
+	}
+	dateColumn, ok := column("data", "date", "horario")
+	if !ok {
+		return nil, errors.New("coluna \"data\" ausente no CSV")
+	}
+	e2eColumn, hasE2E := column("end_to_end_id", "endtoendid", "e2e")
+
+	field := func(record []string, i int) string {
+		if i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	credits := []Credit{}
+	for number, record := range records[1:] {
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		e2e := ""
+		if hasE2E {
+			e2e = field(record, e2eColumn)
+		}
+		credit, err := newCredit(field(record, txidColumn), e2e, field(record, amountColumn), field(record, dateColumn))
+		if err != nil {
+			return nil, fmt.Errorf("linha %d: %v", number+2, err)
+		}
+		credits = append(credits, credit)
+	}
+	return credits, nil
+}
+
+func newCredit(txid, endToEndID, amount, date string) (Credit, error) {
+	cents, err := ParseAmount(amount)
+	if err != nil {
+		return Credit{}, err
+	}
+	paidAt, err := parseDate(date)
+	if err != nil {
+		return Credit{}, err
+	}
+	return Credit{
+		TxID:        strings.TrimSpace(txid),
+		EndToEndID:  strings.TrimSpace(endToEndID),
+		AmountCents: cents,
+		PaidAt:      paidAt,
+	}, nil
+}
+
+// ParseAmount converte "1234.56", "1234,56" ou "1.234,56" em centavos
+func ParseAmount(value string) (int64, error) {
+	value = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(value), "R$"))
+	normalized := value
+	if strings.Contains(value, ",") {
+		normalized = strings.ReplaceAll(strings.ReplaceAll(value, ".", ""), ",", ".")
+	}
+
+	// Só dígitos: ParseInt aceitaria sinal, e "-0.50" viraria um crédito de 50 centavos
+	whole, fraction, _ := strings.Cut(normalized, ".")
+	if len(fraction) > 2 || !isDigits(whole) || (fraction != "" && !isDigits(fraction)) {
+		return 0, fmt.Errorf("valor inválido %q", value)
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+
+	reais, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("valor inválido %q", value)
+	}
+	cents, err := strconv.ParseInt(fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("valor inválido %q", value)
+	}
+	return reais*100 + cents, nil
+}
+
+func isDigits(value string) bool {
+	return value != "" && strings.Trim(value, "0123456789") == ""
+}
+
+func parseDate(value string) (time.Time, error) {
+	if date, err := time.Parse(time.RFC3339, value); err == nil {
+		return date, nil
+	}
+	for _, layout := range []string{"2006-01-02", "02/01/2006"} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("data inválida %q", value)
+}
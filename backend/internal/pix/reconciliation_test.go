@@ -0,0 +1,56 @@
+package pix
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := map[string]int64{
+		"1234.56":      123456,
+		"1234,56":      123456,
+		"1.234,56":     123456,
+		"R$ 1.234,5":   123450,
+		"0.01":         1,
+		"10":           1000,
+		" 1234.50 ":    123450,
+		"1.000.000,00": 100000000,
+	}
+
+	for value, want := range tests {
+		got, err := ParseAmount(value)
+		if err != nil {
+			t.Errorf("ParseAmount(%q): %v", value, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("ParseAmount(%q) = %d, esperado %d", value, got, want)
+		}
+	}
+}
+
+func TestParseAmountInvalid(t *testing.T) {
+	for _, value := range []string{"-0.50", "+0.50", "0.-5", "-10", "12.345", "abc", "", ",50", "1,2,3"} {
+		if got, err := ParseAmount(value); err == nil {
+			t.Errorf("ParseAmount(%q) = %d, esperado erro", value, got)
+		}
+	}
+}
+
+func TestParseStatement(t *testing.T) {
+	json := `{"pix": [{"endToEndId": "E12345678202601151030abcdef12345", "txid": "JC2026000123", "valor": "1234.50", "horario": "2026-01-15T10:30:00Z"}]}`
+	csv := "txid;valor;data\nJC2026000123;1.234,50;15/01/2026\n"
+
+	for name, statement := range map[string]string{"json": json, "csv": csv} {
+		credits, err := ParseStatement(strings.NewReader(statement))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(credits) != 1 || credits[0].TxID != "JC2026000123" || credits[0].AmountCents != 123450 {
+			t.Errorf("%s: %+v", name, credits)
+		}
+		if got := credits[0].PaidAt.Format("2006-01-02"); got != "2026-01-15" {
+			t.Errorf("%s: data = %s", name, got)
+		}
+	}
+}
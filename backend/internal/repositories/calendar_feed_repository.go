@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const calendarFeedsCollection = "calendar_feeds"
+
+type calendarFeedRepository struct {
+	db *database.MongoDB
+}
+
+func NewCalendarFeedRepository(db *database.MongoDB) domain.CalendarFeedRepository {
+	err := db.EnsureIndexes(calendarFeedsCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "token_hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		mongo.IndexModel{Keys: bson.D{{Key: "user_id", Value: 1}}},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &calendarFeedRepository{db: db}
+}
+
+func (r *calendarFeedRepository) Create(feed *domain.CalendarFeed) error {
+	collection := r.db.Database.Collection(calendarFeedsCollection)
+
+	// Garantir que o ID seja nulo para o MongoDB gerar
+	feed.ID = primitive.NilObjectID
+
+	result, err := collection.InsertOne(context.Background(), feed)
+	if err != nil {
+		return err
+	}
+
+	feed.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *calendarFeedRepository) FindByID(id string) (*domain.CalendarFeed, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	return r.findOne(bson.M{"_id": objectID})
+}
+
+func (r *calendarFeedRepository) FindByTokenHash(hash string) (*domain.CalendarFeed, error) {
+	return r.findOne(bson.M{"token_hash": hash})
+}
+
+func (r *calendarFeedRepository) findOne(filter bson.M) (*domain.CalendarFeed, error) {
+	collection := r.db.Database.Collection(calendarFeedsCollection)
+
+	var feed domain.CalendarFeed
+	err := collection.FindOne(context.Background(), filter).Decode(&feed)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrCalendarFeedNotFound
+		}
+		return nil, err
+	}
+
+	return &feed, nil
+}
+
+func (r *calendarFeedRepository) FindByUserID(userID string) ([]*domain.CalendarFeed, error) {
+	collection := r.db.Database.Collection(calendarFeedsCollection)
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := collection.Find(context.Background(), bson.M{"user_id": objectID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	feeds := []*domain.CalendarFeed{}
+	if err = cursor.All(context.Background(), &feeds); err != nil {
+		return nil, err
+	}
+
+	return feeds, nil
+}
+
+func (r *calendarFeedRepository) Revoke(id string) error {
+	collection := r.db.Database.Collection(calendarFeedsCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	filter := bson.M{"_id": objectID, "revoked_at": bson.M{"$exists": false}}
+	result, err := collection.UpdateOne(context.Background(), filter, bson.M{"$set": bson.M{"revoked_at": time.Now()}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		// Revogar uma assinatura já revogada não é erro
+		if _, err := r.FindByID(id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *calendarFeedRepository) RevokeAllByUser(userID string) error {
+	collection := r.db.Database.Collection(calendarFeedsCollection)
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	filter := bson.M{"user_id": objectID, "revoked_at": bson.M{"$exists": false}}
+	_, err = collection.UpdateMany(context.Background(), filter, bson.M{"$set": bson.M{"revoked_at": time.Now()}})
+	return err
+}
+
+func (r *calendarFeedRepository) Touch(id primitive.ObjectID, at time.Time) error {
+	collection := r.db.Database.Collection(calendarFeedsCollection)
+	_, err := collection.UpdateOne(context.Background(), bson.M{"_id": id}, bson.M{"$set": bson.M{"last_accessed_at": at}})
+	return err
+}
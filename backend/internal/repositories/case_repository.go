@@ -0,0 +1,270 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const casesCollection = "cases"
+
+type caseRepository struct {
+	db *database.MongoDB
+}
+
+func NewCaseRepository(db *database.MongoDB) domain.CaseRepository {
+	err := db.EnsureIndexes(casesCollection,
+		// Número CNJ único entre os processos fora da lixeira (deleted_at
+		// ausente); processos ainda sem número não entram no índice
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "number", Value: 1}, {Key: "deleted_at", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"number": bson.M{"$exists": true}}),
+		},
+		mongo.IndexModel{Keys: bson.D{{Key: "client_id", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "lawyer_id", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "parties.document", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "parties.client_id", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "parties.search_name", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "team.user_id", Value: 1}}},
+		// Apenas os registros na lixeira entram no índice
+		mongo.IndexModel{Keys: bson.D{{Key: "deleted_at", Value: 1}}, Options: options.Index().SetSparse(true)},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	// Substituído pelo índice que ignora os processos na lixeira
+	if err := db.DropIndexes(casesCollection, "number_1"); err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &caseRepository{db: db}
+}
+
+func (r *caseRepository) Create(case_ *domain.Case) error {
+	collection := r.db.Database.Collection(casesCollection)
+
+	// Garantir que o ID seja nulo para o MongoDB gerar
+	case_.ID = primitive.NilObjectID
+
+	result, err := collection.InsertOne(context.Background(), case_)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrDuplicateCaseNumber
+		}
+		return err
+	}
+
+	case_.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *caseRepository) FindByID(id string) (*domain.Case, error) {
+	collection := r.db.Database.Collection(casesCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var case_ domain.Case
+	err = collection.FindOne(context.Background(), notDeleted(bson.M{"_id": objectID})).Decode(&case_)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrCaseNotFound
+		}
+		return nil, err
+	}
+
+	return &case_, nil
+}
+
+func (r *caseRepository) FindByNumber(number string) (*domain.Case, error) {
+	collection := r.db.Database.Collection(casesCollection)
+
+	var case_ domain.Case
+	err := collection.FindOne(context.Background(), notDeleted(bson.M{"number": number})).Decode(&case_)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrCaseNotFound
+		}
+		return nil, err
+	}
+
+	return &case_, nil
+}
+
+func (r *caseRepository) FindByClientID(clientID string) ([]*domain.Case, error) {
+	objectID, err := primitive.ObjectIDFromHex(clientID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	return r.find(bson.M{"client_id": objectID})
+}
+
+func (r *caseRepository) FindByLawyerID(lawyerID string) ([]*domain.Case, error) {
+	objectID, err := primitive.ObjectIDFromHex(lawyerID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	return r.find(bson.M{"lawyer_id": objectID})
+}
+
+func (r *caseRepository) FindByParty(query domain.PartyQuery) ([]*domain.Case, error) {
+	match := bson.M{}
+	if query.Document != "" {
+		match["document"] = query.Document
+	}
+	if query.Name != "" {
+		match["name"] = bson.M{"$regex": regexp.QuoteMeta(query.Name), "$options": "i"}
+	}
+	if query.ClientID != "" {
+		objectID, err := primitive.ObjectIDFromHex(query.ClientID)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		match["client_id"] = objectID
+	}
+	if query.Side != "" {
+		match["side"] = query.Side
+	}
+
+	// $elemMatch garante que todos os critérios se apliquem à mesma parte
+	return r.find(bson.M{"parties": bson.M{"$elemMatch": match}})
+}
+
+func (r *caseRepository) FindByTeamMember(userID string) ([]*domain.Case, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	return r.find(bson.M{"team.user_id": objectID})
+}
+
+// FindConflictCandidates retorna processos com alguma parte de mesmo documento
+// ou cujo nome contenha alguma das palavras informadas (já normalizadas)
+func (r *caseRepository) FindConflictCandidates(document string, nameTokens []string) ([]*domain.Case, error) {
+	or := bson.A{}
+	if document != "" {
+		or = append(or, bson.M{"parties.document": document})
+	}
+	if len(nameTokens) > 0 {
+		pattern := nameTokensPattern(nameTokens)
+		or = append(or,
+			bson.M{"parties.search_name": bson.M{"$regex": pattern}},
+			// Partes gravadas antes do nome normalizado
+			bson.M{"parties.name": bson.M{"$regex": pattern, "$options": "i"}},
+		)
+	}
+	if len(or) == 0 {
+		return []*domain.Case{}, nil
+	}
+
+	collection := r.db.Database.Collection(casesCollection)
+	opts := options.Find().SetLimit(conflictCandidatesLimit)
+	cursor, err := collection.Find(context.Background(), notDeleted(bson.M{"$or": or}), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	cases := []*domain.Case{}
+	if err = cursor.All(context.Background(), &cases); err != nil {
+		return nil, err
+	}
+
+	return cases, nil
+}
+
+func (r *caseRepository) find(filter bson.M) ([]*domain.Case, error) {
+	collection := r.db.Database.Collection(casesCollection)
+	opts := options.Find().SetSort(bson.D{{Key: "updated_at", Value: -1}})
+	cursor, err := collection.Find(context.Background(), notDeleted(filter), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	cases := []*domain.Case{}
+	if err = cursor.All(context.Background(), &cases); err != nil {
+		return nil, err
+	}
+
+	return cases, nil
+}
+
+func (r *caseRepository) Update(case_ *domain.Case) error {
+	collection := r.db.Database.Collection(casesCollection)
+	result, err := collection.ReplaceOne(context.Background(), notDeleted(bson.M{"_id": case_.ID}), case_)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrDuplicateCaseNumber
+		}
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrCaseNotFound
+	}
+	return nil
+}
+
+func (r *caseRepository) UpdateStatus(id string, from, to domain.CaseStatus) error {
+	collection := r.db.Database.Collection(casesCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	// Condicionar ao status atual evita transições concorrentes conflitantes
+	filter := notDeleted(bson.M{"_id": objectID, "status": from})
+	update := bson.M{"$set": bson.M{"status": to, "updated_at": time.Now()}}
+
+	result, err := collection.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		if _, err := r.FindByID(id); err != nil {
+			return err
+		}
+		return ErrCaseStatusConflict
+	}
+
+	return nil
+}
+
+// Delete move o processo para a lixeira
+func (r *caseRepository) Delete(id string, deletedBy primitive.ObjectID) error {
+	return moveToTrash(r.db.Database.Collection(casesCollection), id, deletedBy, ErrCaseNotFound)
+}
+
+func (r *caseRepository) Restore(id string) error {
+	return restoreFromTrash(r.db.Database.Collection(casesCollection), id, ErrCaseNotFound)
+}
+
+func (r *caseRepository) FindDeleted(deletedBefore time.Time) ([]*domain.Case, error) {
+	cases := []*domain.Case{}
+	if err := findDeleted(r.db.Database.Collection(casesCollection), deletedBefore, &cases); err != nil {
+		return nil, err
+	}
+	return cases, nil
+}
+
+func (r *caseRepository) FindDeletedByID(id string) (*domain.Case, error) {
+	var case_ domain.Case
+	if err := findDeletedByID(r.db.Database.Collection(casesCollection), id, ErrCaseNotFound, &case_); err != nil {
+		return nil, err
+	}
+	return &case_, nil
+}
+
+// Purge remove o processo definitivamente
+func (r *caseRepository) Purge(id string) error {
+	return purge(r.db.Database.Collection(casesCollection), id, ErrCaseNotFound)
+}
@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"context"
+	"log"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const caseStatusHistoryCollection = "case_status_history"
+
+type caseStatusHistoryRepository struct {
+	db *database.MongoDB
+}
+
+func NewCaseStatusHistoryRepository(db *database.MongoDB) domain.CaseStatusHistoryRepository {
+	err := db.EnsureIndexes(caseStatusHistoryCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "case_id", Value: 1}, {Key: "changed_at", Value: 1}}},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &caseStatusHistoryRepository{db: db}
+}
+
+func (r *caseStatusHistoryRepository) Create(change *domain.CaseStatusChange) error {
+	collection := r.db.Database.Collection(caseStatusHistoryCollection)
+
+	change.ID = primitive.NilObjectID
+
+	result, err := collection.InsertOne(context.Background(), change)
+	if err != nil {
+		return err
+	}
+
+	change.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+// FindByCaseID lista as mudanças em ordem cronológica, opcionalmente filtrando
+// pelas entradas em um status específico
+func (r *caseStatusHistoryRepository) FindByCaseID(caseID string, status domain.CaseStatus) ([]*domain.CaseStatusChange, error) {
+	collection := r.db.Database.Collection(caseStatusHistoryCollection)
+	objectID, err := primitive.ObjectIDFromHex(caseID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	filter := bson.M{"case_id": objectID}
+	if status != "" {
+		filter["to_status"] = status
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "changed_at", Value: 1}})
+	cursor, err := collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	changes := []*domain.CaseStatusChange{}
+	if err = cursor.All(context.Background(), &changes); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,205 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const clientsCollection = "clients"
+
+// conflictCandidatesLimit limita os candidatos avaliados por pessoa na verificação de conflito
+const conflictCandidatesLimit = 200
+
+type clientRepository struct {
+	db *database.MongoDB
+}
+
+func NewClientRepository(db *database.MongoDB) domain.ClientRepository {
+	err := db.EnsureIndexes(clientsCollection,
+		// CPF/CNPJ único entre os clientes fora da lixeira (deleted_at ausente)
+		mongo.IndexModel{Keys: bson.D{{Key: "document", Value: 1}, {Key: "deleted_at", Value: 1}}, Options: options.Index().SetUnique(true)},
+		mongo.IndexModel{Keys: bson.D{{Key: "search_name", Value: 1}}},
+		// Apenas os registros na lixeira entram no índice
+		mongo.IndexModel{Keys: bson.D{{Key: "deleted_at", Value: 1}}, Options: options.Index().SetSparse(true)},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	// Substituído pelo índice que ignora os clientes na lixeira
+	if err := db.DropIndexes(clientsCollection, "document_1"); err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &clientRepository{db: db}
+}
+
+func (r *clientRepository) Create(client *domain.Client) error {
+	collection := r.db.Database.Collection(clientsCollection)
+
+	// Garantir que o ID seja nulo para o MongoDB gerar
+	client.ID = primitive.NilObjectID
+
+	result, err := collection.InsertOne(context.Background(), client)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrDuplicateClientDocument
+		}
+		return err
+	}
+
+	client.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *clientRepository) FindByID(id string) (*domain.Client, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	return r.findOne(bson.M{"_id": objectID})
+}
+
+func (r *clientRepository) FindByDocument(document string) (*domain.Client, error) {
+	return r.findOne(bson.M{"document": document})
+}
+
+func (r *clientRepository) findOne(filter bson.M) (*domain.Client, error) {
+	collection := r.db.Database.Collection(clientsCollection)
+
+	var client domain.Client
+	err := collection.FindOne(context.Background(), notDeleted(filter)).Decode(&client)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrClientNotFound
+		}
+		return nil, err
+	}
+
+	return &client, nil
+}
+
+// Search espera o nome já normalizado (sem acentos, minúsculo) e o documento
+// apenas com dígitos; o documento é comparado pelo prefixo
+func (r *clientRepository) Search(query domain.ClientQuery) ([]*domain.Client, error) {
+	collection := r.db.Database.Collection(clientsCollection)
+
+	filter := notDeleted(bson.M{})
+	if query.Name != "" {
+		filter["search_name"] = bson.M{"$regex": regexp.QuoteMeta(query.Name)}
+	}
+	if query.Document != "" {
+		filter["document"] = bson.M{"$regex": "^" + regexp.QuoteMeta(query.Document)}
+	}
+	if query.Type != "" {
+		filter["type"] = query.Type
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "search_name", Value: 1}})
+	cursor, err := collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	clients := []*domain.Client{}
+	if err = cursor.All(context.Background(), &clients); err != nil {
+		return nil, err
+	}
+
+	return clients, nil
+}
+
+// FindConflictCandidates retorna clientes com o mesmo documento ou que tenham
+// no nome alguma das palavras informadas (já normalizadas)
+func (r *clientRepository) FindConflictCandidates(document string, nameTokens []string) ([]*domain.Client, error) {
+	collection := r.db.Database.Collection(clientsCollection)
+
+	or := bson.A{}
+	if document != "" {
+		or = append(or, bson.M{"document": document})
+	}
+	if len(nameTokens) > 0 {
+		or = append(or, bson.M{"search_name": bson.M{"$regex": nameTokensPattern(nameTokens)}})
+	}
+	if len(or) == 0 {
+		return []*domain.Client{}, nil
+	}
+
+	opts := options.Find().SetLimit(conflictCandidatesLimit)
+	cursor, err := collection.Find(context.Background(), notDeleted(bson.M{"$or": or}), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	clients := []*domain.Client{}
+	if err = cursor.All(context.Background(), &clients); err != nil {
+		return nil, err
+	}
+
+	return clients, nil
+}
+
+func (r *clientRepository) Update(client *domain.Client) error {
+	collection := r.db.Database.Collection(clientsCollection)
+	result, err := collection.ReplaceOne(context.Background(), notDeleted(bson.M{"_id": client.ID}), client)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrDuplicateClientDocument
+		}
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrClientNotFound
+	}
+	return nil
+}
+
+// Delete move o cliente para a lixeira
+func (r *clientRepository) Delete(id string, deletedBy primitive.ObjectID) error {
+	return moveToTrash(r.db.Database.Collection(clientsCollection), id, deletedBy, ErrClientNotFound)
+}
+
+func (r *clientRepository) Restore(id string) error {
+	return restoreFromTrash(r.db.Database.Collection(clientsCollection), id, ErrClientNotFound)
+}
+
+func (r *clientRepository) FindDeleted(deletedBefore time.Time) ([]*domain.Client, error) {
+	clients := []*domain.Client{}
+	if err := findDeleted(r.db.Database.Collection(clientsCollection), deletedBefore, &clients); err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+func (r *clientRepository) FindDeletedByID(id string) (*domain.Client, error) {
+	var client domain.Client
+	if err := findDeletedByID(r.db.Database.Collection(clientsCollection), id, ErrClientNotFound, &client); err != nil {
+		return nil, err
+	}
+	return &client, nil
+}
+
+// Purge remove o cliente definitivamente
+func (r *clientRepository) Purge(id string) error {
+	return purge(r.db.Database.Collection(clientsCollection), id, ErrClientNotFound)
+}
+
+// nameTokensPattern monta uma expressão que casa qualquer das palavras inteiras
+func nameTokensPattern(tokens []string) string {
+	quoted := make([]string, len(tokens))
+	for i, token := range tokens {
+		quoted[i] = regexp.QuoteMeta(token)
+	}
+	return "(^| )(" + strings.Join(quoted, "|") + ")( |$)"
+}
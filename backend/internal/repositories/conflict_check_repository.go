@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"context"
+	"log"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const conflictChecksCollection = "conflict_checks"
+
+type conflictCheckRepository struct {
+	db *database.MongoDB
+}
+
+func NewConflictCheckRepository(db *database.MongoDB) domain.ConflictCheckRepository {
+	err := db.EnsureIndexes(conflictChecksCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "target_type", Value: 1}, {Key: "target_id", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "created_at", Value: -1}}},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &conflictCheckRepository{db: db}
+}
+
+func (r *conflictCheckRepository) Create(check *domain.ConflictCheck) error {
+	collection := r.db.Database.Collection(conflictChecksCollection)
+
+	// O ID vem do serviço, que já o referenciou no override do cadastro
+	if check.ID.IsZero() {
+		check.ID = primitive.NewObjectID()
+	}
+
+	_, err := collection.InsertOne(context.Background(), check)
+	return err
+}
+
+func (r *conflictCheckRepository) FindAll() ([]*domain.ConflictCheck, error) {
+	return r.find(bson.M{})
+}
+
+func (r *conflictCheckRepository) FindByTarget(targetType, targetID string) ([]*domain.ConflictCheck, error) {
+	objectID, err := primitive.ObjectIDFromHex(targetID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	return r.find(bson.M{"target_type": targetType, "target_id": objectID})
+}
+
+func (r *conflictCheckRepository) find(filter bson.M) ([]*domain.ConflictCheck, error) {
+	collection := r.db.Database.Collection(conflictChecksCollection)
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	checks := []*domain.ConflictCheck{}
+	if err = cursor.All(context.Background(), &checks); err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
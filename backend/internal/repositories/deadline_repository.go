@@ -0,0 +1,135 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const deadlinesCollection = "deadlines"
+
+type deadlineRepository struct {
+	db *database.MongoDB
+}
+
+func NewDeadlineRepository(db *database.MongoDB) domain.DeadlineRepository {
+	err := db.EnsureIndexes(deadlinesCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "case_id", Value: 1}, {Key: "due_date", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "assigned_to", Value: 1}, {Key: "due_date", Value: 1}}},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &deadlineRepository{db: db}
+}
+
+func (r *deadlineRepository) Create(deadline *domain.Deadline) error {
+	collection := r.db.Database.Collection(deadlinesCollection)
+
+	// Garantir que o ID seja nulo para o MongoDB gerar
+	deadline.ID = primitive.NilObjectID
+
+	result, err := collection.InsertOne(context.Background(), deadline)
+	if err != nil {
+		return err
+	}
+
+	deadline.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *deadlineRepository) FindByID(id string) (*domain.Deadline, error) {
+	collection := r.db.Database.Collection(deadlinesCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var deadline domain.Deadline
+	err = collection.FindOne(context.Background(), bson.M{"_id": objectID}).Decode(&deadline)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrDeadlineNotFound
+		}
+		return nil, err
+	}
+
+	return &deadline, nil
+}
+
+// FindByCaseID lista os prazos do processo por vencimento, opcionalmente filtrando pelo status
+func (r *deadlineRepository) FindByCaseID(caseID string, status domain.DeadlineStatus) ([]*domain.Deadline, error) {
+	objectID, err := primitive.ObjectIDFromHex(caseID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	filter := bson.M{"case_id": objectID}
+	if status != "" {
+		filter["status"] = status
+	}
+	return r.find(filter)
+}
+
+func (r *deadlineRepository) FindByAssignee(userID string, from time.Time) ([]*domain.Deadline, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	return r.find(bson.M{"assigned_to": objectID, "due_date": bson.M{"$gte": from}})
+}
+
+func (r *deadlineRepository) find(filter bson.M) ([]*domain.Deadline, error) {
+	collection := r.db.Database.Collection(deadlinesCollection)
+	opts := options.Find().SetSort(bson.D{{Key: "due_date", Value: 1}})
+	cursor, err := collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	deadlines := []*domain.Deadline{}
+	if err = cursor.All(context.Background(), &deadlines); err != nil {
+		return nil, err
+	}
+
+	return deadlines, nil
+}
+
+func (r *deadlineRepository) Update(deadline *domain.Deadline) error {
+	collection := r.db.Database.Collection(deadlinesCollection)
+	result, err := collection.ReplaceOne(context.Background(), bson.M{"_id": deadline.ID}, deadline)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrDeadlineNotFound
+	}
+	return nil
+}
+
+func (r *deadlineRepository) Delete(id string) error {
+	collection := r.db.Database.Collection(deadlinesCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := collection.DeleteOne(context.Background(), bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrDeadlineNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const documentsCollection = "documents"
+
+type documentRepository struct {
+	db *database.MongoDB
+}
+
+func NewDocumentRepository(db *database.MongoDB) domain.DocumentRepository {
+	err := db.EnsureIndexes(documentsCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "case_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		// Apenas os registros na lixeira entram no índice
+		mongo.IndexModel{Keys: bson.D{{Key: "deleted_at", Value: 1}}, Options: options.Index().SetSparse(true)},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &documentRepository{db: db}
+}
+
+func (r *documentRepository) Create(document *domain.Document) error {
+	collection := r.db.Database.Collection(documentsCollection)
+
+	// O ID pode ser pré-definido para compor a chave do arquivo no armazenamento
+	if document.ID.IsZero() {
+		document.ID = primitive.NewObjectID()
+	}
+
+	_, err := collection.InsertOne(context.Background(), document)
+	return err
+}
+
+func (r *documentRepository) FindByID(id string) (*domain.Document, error) {
+	collection := r.db.Database.Collection(documentsCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var document domain.Document
+	err = collection.FindOne(context.Background(), notDeleted(bson.M{"_id": objectID})).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrDocumentNotFound
+		}
+		return nil, err
+	}
+
+	return &document, nil
+}
+
+func (r *documentRepository) FindByCaseID(caseID string) ([]*domain.Document, error) {
+	collection := r.db.Database.Collection(documentsCollection)
+	objectID, err := primitive.ObjectIDFromHex(caseID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := collection.Find(context.Background(), notDeleted(bson.M{"case_id": objectID}), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	documents := []*domain.Document{}
+	if err = cursor.All(context.Background(), &documents); err != nil {
+		return nil, err
+	}
+
+	return documents, nil
+}
+
+func (r *documentRepository) Update(document *domain.Document) error {
+	collection := r.db.Database.Collection(documentsCollection)
+	result, err := collection.ReplaceOne(context.Background(), notDeleted(bson.M{"_id": document.ID}), document)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrDocumentNotFound
+	}
+	return nil
+}
+
+// Delete move o documento para a lixeira
+func (r *documentRepository) Delete(id string, deletedBy primitive.ObjectID) error {
+	return moveToTrash(r.db.Database.Collection(documentsCollection), id, deletedBy, ErrDocumentNotFound)
+}
+
+func (r *documentRepository) Restore(id string) error {
+	return restoreFromTrash(r.db.Database.Collection(documentsCollection), id, ErrDocumentNotFound)
+}
+
+func (r *documentRepository) FindDeleted(deletedBefore time.Time) ([]*domain.Document, error) {
+	documents := []*domain.Document{}
+	if err := findDeleted(r.db.Database.Collection(documentsCollection), deletedBefore, &documents); err != nil {
+		return nil, err
+	}
+	return documents, nil
+}
+
+func (r *documentRepository) FindDeletedByID(id string) (*domain.Document, error) {
+	var document domain.Document
+	if err := findDeletedByID(r.db.Database.Collection(documentsCollection), id, ErrDocumentNotFound, &document); err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+// Purge remove o documento definitivamente
+func (r *documentRepository) Purge(id string) error {
+	return purge(r.db.Database.Collection(documentsCollection), id, ErrDocumentNotFound)
+}
@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const documentVersionsCollection = "document_versions"
+
+type documentVersionRepository struct {
+	db *database.MongoDB
+}
+
+func NewDocumentVersionRepository(db *database.MongoDB) domain.DocumentVersionRepository {
+	err := db.EnsureIndexes(documentVersionsCollection,
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "document_id", Value: 1}, {Key: "version", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &documentVersionRepository{db: db}
+}
+
+func (r *documentVersionRepository) Create(version *domain.DocumentVersion) error {
+	collection := r.db.Database.Collection(documentVersionsCollection)
+
+	version.ID = primitive.NilObjectID
+
+	result, err := collection.InsertOne(context.Background(), version)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrDuplicateDocumentVersion
+		}
+		return err
+	}
+
+	version.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *documentVersionRepository) FindByDocumentID(documentID string) ([]*domain.DocumentVersion, error) {
+	collection := r.db.Database.Collection(documentVersionsCollection)
+	objectID, err := primitive.ObjectIDFromHex(documentID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "version", Value: -1}})
+	cursor, err := collection.Find(context.Background(), bson.M{"document_id": objectID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	versions := []*domain.DocumentVersion{}
+	if err = cursor.All(context.Background(), &versions); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+func (r *documentVersionRepository) FindVersion(documentID string, version int) (*domain.DocumentVersion, error) {
+	collection := r.db.Database.Collection(documentVersionsCollection)
+	objectID, err := primitive.ObjectIDFromHex(documentID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var result domain.DocumentVersion
+	err = collection.FindOne(context.Background(), bson.M{"document_id": objectID, "version": version}).Decode(&result)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrDocumentVersionNotFound
+		}
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (r *documentVersionRepository) DeleteByDocumentID(documentID string) error {
+	collection := r.db.Database.Collection(documentVersionsCollection)
+	objectID, err := primitive.ObjectIDFromHex(documentID)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	_, err = collection.DeleteMany(context.Background(), bson.M{"document_id": objectID})
+	return err
+}
@@ -11,4 +11,91 @@ var (
 
 	// ErrDuplicateOAB é retornado quando tenta-se criar um advogado com OAB já existente
 	ErrDuplicateOAB = errors.New("OAB já está em uso")
+
+	// ErrCaseNotFound é retornado quando um processo não é encontrado
+	ErrCaseNotFound = errors.New("processo não encontrado")
+
+	// ErrDuplicateCaseNumber é retornado quando tenta-se cadastrar um número de processo já existente
+	ErrDuplicateCaseNumber = errors.New("número de processo já cadastrado")
+
+	// ErrCaseStatusConflict é retornado quando o status do processo foi alterado por outra requisição
+	ErrCaseStatusConflict = errors.New("o status do processo foi alterado por outra operação")
+
+	// ErrClientNotFound é retornado quando um cliente não é encontrado
+	ErrClientNotFound = errors.New("cliente não encontrado")
+
+	// ErrDuplicateClientDocument é retornado quando tenta-se cadastrar um CPF/CNPJ já existente
+	ErrDuplicateClientDocument = errors.New("já existe um cliente com este CPF/CNPJ")
+
+	// ErrDeadlineNotFound é retornado quando um prazo não é encontrado
+	ErrDeadlineNotFound = errors.New("prazo não encontrado")
+
+	// ErrEventNotFound é retornado quando um compromisso não é encontrado
+	ErrEventNotFound = errors.New("compromisso não encontrado")
+
+	// ErrCalendarFeedNotFound é retornado quando uma assinatura de agenda não é encontrada
+	ErrCalendarFeedNotFound = errors.New("assinatura de agenda não encontrada")
+
+	// ErrTaskNotFound é retornado quando uma tarefa não é encontrada
+	ErrTaskNotFound = errors.New("tarefa não encontrada")
+
+	// ErrTimeEntryNotFound é retornado quando um lançamento de horas não é encontrado
+	ErrTimeEntryNotFound = errors.New("lançamento de horas não encontrado")
+
+	// ErrTimerAlreadyRunning é retornado quando o usuário já tem um cronômetro em andamento
+	ErrTimerAlreadyRunning = errors.New("já existe um cronômetro em andamento para este usuário")
+
+	// ErrFeeAgreementNotFound é retornado quando um contrato de honorários não é encontrado
+	ErrFeeAgreementNotFound = errors.New("contrato de honorários não encontrado")
+
+	// ErrInvoiceNotFound é retornado quando uma fatura não é encontrada
+	ErrInvoiceNotFound = errors.New("fatura não encontrada")
+
+	// ErrAlreadyInvoiced é retornado quando um lançamento já foi vinculado a outra fatura
+	ErrAlreadyInvoiced = errors.New("lançamento já vinculado a outra fatura")
+
+	// ErrFeeAgreementConflict é retornado quando o contrato de honorários foi alterado por outra requisição
+	ErrFeeAgreementConflict = errors.New("o contrato de honorários foi alterado por outra operação")
+
+	// ErrExpenseNotFound é retornado quando uma despesa não é encontrada
+	ErrExpenseNotFound = errors.New("despesa não encontrada")
+
+	// ErrHolidayNotFound é retornado quando um feriado não é encontrado
+	ErrHolidayNotFound = errors.New("feriado não encontrado")
+
+	// ErrDuplicateHoliday é retornado quando o feriado já está cadastrado para o mesmo juízo
+	ErrDuplicateHoliday = errors.New("feriado já cadastrado para este período e juízo")
+
+	// ErrDocumentNotFound é retornado quando um documento não é encontrado
+	ErrDocumentNotFound = errors.New("documento não encontrado")
+
+	// ErrDocumentVersionNotFound é retornado quando a versão do documento não é encontrada
+	ErrDocumentVersionNotFound = errors.New("versão do documento não encontrada")
+
+	// ErrDuplicateDocumentVersion é retornado quando duas versões com o mesmo número são gravadas
+	ErrDuplicateDocumentVersion = errors.New("outra versão do documento foi gravada simultaneamente")
+
+	// ErrInvalidID é retornado quando o identificador informado não é um ObjectID válido
+	ErrInvalidID = errors.New("ID inválido")
+
+	// ErrRoleNotFound é retornado quando uma role não é encontrada
+	ErrRoleNotFound = errors.New("role não encontrada")
+
+	// ErrDuplicateRole é retornado quando tenta-se criar uma role com nome já existente
+	ErrDuplicateRole = errors.New("já existe uma role com este nome")
+
+	// ErrRefreshTokenNotFound é retornado quando um refresh token não é encontrado
+	ErrRefreshTokenNotFound = errors.New("refresh token não encontrado")
+
+	// ErrRefreshTokenAlreadyRevoked é retornado quando tenta-se rotacionar um refresh token já revogado
+	ErrRefreshTokenAlreadyRevoked = errors.New("refresh token já revogado")
+
+	// ErrLoginThrottleNotFound é retornado quando não há falhas de login registradas
+	ErrLoginThrottleNotFound = errors.New("registro de tentativas de login não encontrado")
+
+	// ErrPasswordResetTokenNotFound é retornado quando o token de redefinição de senha não existe
+	ErrPasswordResetTokenNotFound = errors.New("token de redefinição de senha não encontrado")
+
+	// ErrPasswordResetTokenUsed é retornado quando o token de redefinição de senha já foi usado
+	ErrPasswordResetTokenUsed = errors.New("token de redefinição de senha já utilizado")
 )
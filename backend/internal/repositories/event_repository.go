@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const eventsCollection = "events"
+
+type eventRepository struct {
+	db *database.MongoDB
+}
+
+func NewEventRepository(db *database.MongoDB) domain.EventRepository {
+	err := db.EnsureIndexes(eventsCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "lawyer_id", Value: 1}, {Key: "start_at", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "participants", Value: 1}, {Key: "start_at", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "case_id", Value: 1}, {Key: "start_at", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "series_id", Value: 1}}},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &eventRepository{db: db}
+}
+
+// Create grava um ou mais compromissos (as ocorrências de uma série) de uma vez
+func (r *eventRepository) Create(events ...*domain.Event) error {
+	collection := r.db.Database.Collection(eventsCollection)
+
+	documents := make([]interface{}, len(events))
+	for i, event := range events {
+		if event.ID.IsZero() {
+			event.ID = primitive.NewObjectID()
+		}
+		documents[i] = event
+	}
+
+	_, err := collection.InsertMany(context.Background(), documents)
+	return err
+}
+
+func (r *eventRepository) FindByID(id string) (*domain.Event, error) {
+	collection := r.db.Database.Collection(eventsCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var event domain.Event
+	err = collection.FindOne(context.Background(), bson.M{"_id": objectID}).Decode(&event)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrEventNotFound
+		}
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// Find lista os compromissos que se sobrepõem ao período, em ordem cronológica
+func (r *eventRepository) Find(query domain.EventQuery) ([]*domain.Event, error) {
+	filter := bson.M{
+		"start_at": bson.M{"$lt": query.To},
+		"end_at":   bson.M{"$gt": query.From},
+	}
+	if query.UserID != "" {
+		userID, err := primitive.ObjectIDFromHex(query.UserID)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		filter["$or"] = bson.A{bson.M{"lawyer_id": userID}, bson.M{"participants": userID}}
+	}
+	if query.CaseID != "" {
+		caseID, err := primitive.ObjectIDFromHex(query.CaseID)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		filter["case_id"] = caseID
+	}
+	if query.Type != "" {
+		filter["type"] = query.Type
+	}
+	if query.Status != "" {
+		filter["status"] = query.Status
+	}
+	return r.find(filter)
+}
+
+func (r *eventRepository) FindOverlapping(userIDs []primitive.ObjectID, start, end time.Time, excludeIDs []primitive.ObjectID) ([]*domain.Event, error) {
+	filter := bson.M{
+		"start_at": bson.M{"$lt": end},
+		"end_at":   bson.M{"$gt": start},
+		"status":   bson.M{"$ne": domain.EventStatusCancelled},
+		"$or": bson.A{
+			bson.M{"lawyer_id": bson.M{"$in": userIDs}},
+			bson.M{"participants": bson.M{"$in": userIDs}},
+		},
+	}
+	if len(excludeIDs) > 0 {
+		filter["_id"] = bson.M{"$nin": excludeIDs}
+	}
+	return r.find(filter)
+}
+
+func (r *eventRepository) find(filter bson.M) ([]*domain.Event, error) {
+	collection := r.db.Database.Collection(eventsCollection)
+	opts := options.Find().SetSort(bson.D{{Key: "start_at", Value: 1}})
+	cursor, err := collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	events := []*domain.Event{}
+	if err = cursor.All(context.Background(), &events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *eventRepository) Update(event *domain.Event) error {
+	collection := r.db.Database.Collection(eventsCollection)
+	result, err := collection.ReplaceOne(context.Background(), bson.M{"_id": event.ID}, event)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrEventNotFound
+	}
+	return nil
+}
+
+func (r *eventRepository) Delete(id string) error {
+	collection := r.db.Database.Collection(eventsCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := collection.DeleteOne(context.Background(), bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrEventNotFound
+	}
+
+	return nil
+}
+
+// DeleteSeries remove as ocorrências da série a partir da data informada
+func (r *eventRepository) DeleteSeries(seriesID string, from time.Time) (int64, error) {
+	collection := r.db.Database.Collection(eventsCollection)
+	objectID, err := primitive.ObjectIDFromHex(seriesID)
+	if err != nil {
+		return 0, ErrInvalidID
+	}
+
+	result, err := collection.DeleteMany(context.Background(), bson.M{
+		"series_id": objectID,
+		"start_at":  bson.M{"$gte": from},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
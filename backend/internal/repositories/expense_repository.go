@@ -0,0 +1,172 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const expensesCollection = "expenses"
+
+type expenseRepository struct {
+	db *database.MongoDB
+}
+
+func NewExpenseRepository(db *database.MongoDB) domain.ExpenseRepository {
+	err := db.EnsureIndexes(expensesCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "case_id", Value: 1}, {Key: "date", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "client_id", Value: 1}, {Key: "invoice_id", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "approver_id", Value: 1}, {Key: "status", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "created_by", Value: 1}, {Key: "date", Value: 1}}},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &expenseRepository{db: db}
+}
+
+func (r *expenseRepository) Create(expense *domain.Expense) error {
+	collection := r.db.Database.Collection(expensesCollection)
+
+	// Garantir que o ID seja nulo para o MongoDB gerar
+	expense.ID = primitive.NilObjectID
+
+	result, err := collection.InsertOne(context.Background(), expense)
+	if err != nil {
+		return err
+	}
+
+	expense.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *expenseRepository) FindByID(id string) (*domain.Expense, error) {
+	collection := r.db.Database.Collection(expensesCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var expense domain.Expense
+	err = collection.FindOne(context.Background(), bson.M{"_id": objectID}).Decode(&expense)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrExpenseNotFound
+		}
+		return nil, err
+	}
+
+	return &expense, nil
+}
+
+func (r *expenseRepository) Find(query domain.ExpenseQuery) ([]*domain.Expense, error) {
+	filter := bson.M{}
+	for field, value := range map[string]string{
+		"case_id":     query.CaseID,
+		"client_id":   query.ClientID,
+		"created_by":  query.CreatedBy,
+		"approver_id": query.ApproverID,
+	} {
+		if value == "" {
+			continue
+		}
+		objectID, err := primitive.ObjectIDFromHex(value)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		filter[field] = objectID
+	}
+	if query.Status != "" {
+		filter["status"] = query.Status
+	}
+	if query.Unbilled {
+		filter["status"] = domain.ExpenseStatusApproved
+		filter["bill_to_client"] = true
+		filter["invoice_id"] = bson.M{"$exists": false}
+	}
+	date := bson.M{}
+	if !query.From.IsZero() {
+		date["$gte"] = query.From
+	}
+	if !query.To.IsZero() {
+		date["$lt"] = query.To
+	}
+	if len(date) > 0 {
+		filter["date"] = date
+	}
+
+	collection := r.db.Database.Collection(expensesCollection)
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: 1}, {Key: "created_at", Value: 1}})
+	cursor, err := collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	expenses := []*domain.Expense{}
+	if err = cursor.All(context.Background(), &expenses); err != nil {
+		return nil, err
+	}
+
+	return expenses, nil
+}
+
+func (r *expenseRepository) Update(expense *domain.Expense) error {
+	collection := r.db.Database.Collection(expensesCollection)
+	result, err := collection.ReplaceOne(context.Background(), bson.M{"_id": expense.ID}, expense)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrExpenseNotFound
+	}
+	return nil
+}
+
+func (r *expenseRepository) Delete(id string) error {
+	collection := r.db.Database.Collection(expensesCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := collection.DeleteOne(context.Background(), bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrExpenseNotFound
+	}
+
+	return nil
+}
+
+func (r *expenseRepository) SetInvoice(ids []primitive.ObjectID, invoiceID primitive.ObjectID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	collection := r.db.Database.Collection(expensesCollection)
+	// Só vincula os que continuam livres, para não tirá-los de outra fatura
+	filter := bson.M{"_id": bson.M{"$in": ids}, "invoice_id": bson.M{"$exists": false}}
+	result, err := collection.UpdateMany(context.Background(), filter, bson.M{"$set": bson.M{"invoice_id": invoiceID}})
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount != int64(len(ids)) {
+		return ErrAlreadyInvoiced
+	}
+	return nil
+}
+
+func (r *expenseRepository) ReleaseInvoice(invoiceID primitive.ObjectID) error {
+	collection := r.db.Database.Collection(expensesCollection)
+	_, err := collection.UpdateMany(context.Background(), bson.M{"invoice_id": invoiceID}, bson.M{"$unset": bson.M{"invoice_id": ""}})
+	return err
+}
@@ -0,0 +1,167 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const feeAgreementsCollection = "fee_agreements"
+
+type feeAgreementRepository struct {
+	db *database.MongoDB
+}
+
+func NewFeeAgreementRepository(db *database.MongoDB) domain.FeeAgreementRepository {
+	err := db.EnsureIndexes(feeAgreementsCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "client_id", Value: 1}, {Key: "is_active", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "case_id", Value: 1}}},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &feeAgreementRepository{db: db}
+}
+
+func (r *feeAgreementRepository) Create(agreement *domain.FeeAgreement) error {
+	collection := r.db.Database.Collection(feeAgreementsCollection)
+
+	// Garantir que o ID seja nulo para o MongoDB gerar
+	agreement.ID = primitive.NilObjectID
+
+	result, err := collection.InsertOne(context.Background(), agreement)
+	if err != nil {
+		return err
+	}
+
+	agreement.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *feeAgreementRepository) FindByID(id string) (*domain.FeeAgreement, error) {
+	collection := r.db.Database.Collection(feeAgreementsCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var agreement domain.FeeAgreement
+	err = collection.FindOne(context.Background(), bson.M{"_id": objectID}).Decode(&agreement)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrFeeAgreementNotFound
+		}
+		return nil, err
+	}
+
+	return &agreement, nil
+}
+
+func (r *feeAgreementRepository) FindByClientID(clientID string, activeOnly bool) ([]*domain.FeeAgreement, error) {
+	collection := r.db.Database.Collection(feeAgreementsCollection)
+	objectID, err := primitive.ObjectIDFromHex(clientID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	filter := bson.M{"client_id": objectID}
+	if activeOnly {
+		filter["is_active"] = true
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "start_date", Value: 1}})
+	cursor, err := collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	agreements := []*domain.FeeAgreement{}
+	if err = cursor.All(context.Background(), &agreements); err != nil {
+		return nil, err
+	}
+
+	return agreements, nil
+}
+
+func (r *feeAgreementRepository) Update(agreement *domain.FeeAgreement) error {
+	collection := r.db.Database.Collection(feeAgreementsCollection)
+	result, err := collection.ReplaceOne(context.Background(), bson.M{"_id": agreement.ID}, agreement)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrFeeAgreementNotFound
+	}
+	return nil
+}
+
+func (r *feeAgreementRepository) UpdateIfUnchanged(agreement *domain.FeeAgreement, previousUpdatedAt time.Time) error {
+	collection := r.db.Database.Collection(feeAgreementsCollection)
+	filter := bson.M{"_id": agreement.ID, "updated_at": previousUpdatedAt}
+	result, err := collection.ReplaceOne(context.Background(), filter, agreement)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrFeeAgreementConflict
+	}
+	return nil
+}
+
+// ReleaseInvoice desfaz, numa única operação, o que a fatura cobrou do
+// contrato: remove as competências informadas e o vínculo dos honorários fixos
+// ou de êxito, se ainda apontar para a fatura. updated_at muda para que uma
+// cobrança concorrente (UpdateIfUnchanged) perceba a alteração.
+func (r *feeAgreementRepository) ReleaseInvoice(id primitive.ObjectID, invoiceID primitive.ObjectID, periods []string) error {
+	collection := r.db.Database.Collection(feeAgreementsCollection)
+	if periods == nil {
+		periods = []string{}
+	}
+
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"billed_periods": bson.M{"$filter": bson.M{
+			"input": bson.M{"$ifNull": bson.A{"$billed_periods", bson.A{}}},
+			"cond":  bson.M{"$not": bson.A{bson.M{"$in": bson.A{"$$this", bson.M{"$literal": periods}}}}},
+		}},
+		"billed_invoice_id": bson.M{"$cond": bson.A{
+			bson.M{"$eq": bson.A{"$billed_invoice_id", invoiceID}}, "$$REMOVE", "$billed_invoice_id",
+		}},
+		"updated_at": time.Now(),
+	}}}}
+
+	result, err := collection.UpdateOne(context.Background(), bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrFeeAgreementNotFound
+	}
+	return nil
+}
+
+func (r *feeAgreementRepository) Delete(id string) error {
+	collection := r.db.Database.Collection(feeAgreementsCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := collection.DeleteOne(context.Background(), bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrFeeAgreementNotFound
+	}
+
+	return nil
+}
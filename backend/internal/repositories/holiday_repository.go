@@ -0,0 +1,159 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const holidaysCollection = "holidays"
+
+type holidayRepository struct {
+	db *database.MongoDB
+}
+
+func NewHolidayRepository(db *database.MongoDB) domain.HolidayRepository {
+	err := db.EnsureIndexes(holidaysCollection,
+		// Evita o mesmo feriado cadastrado duas vezes para o mesmo juízo (ex.: reimportação)
+		mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "date", Value: 1}, {Key: "end_date", Value: 1},
+				{Key: "scope", Value: 1}, {Key: "state", Value: 1}, {Key: "court", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		mongo.IndexModel{Keys: bson.D{{Key: "recurring", Value: 1}}},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &holidayRepository{db: db}
+}
+
+func (r *holidayRepository) Create(holiday *domain.Holiday) error {
+	collection := r.db.Database.Collection(holidaysCollection)
+
+	// Garantir que o ID seja nulo para o MongoDB gerar
+	holiday.ID = primitive.NilObjectID
+
+	result, err := collection.InsertOne(context.Background(), holiday)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrDuplicateHoliday
+		}
+		return err
+	}
+
+	holiday.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *holidayRepository) FindByID(id string) (*domain.Holiday, error) {
+	collection := r.db.Database.Collection(holidaysCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var holiday domain.Holiday
+	err = collection.FindOne(context.Background(), bson.M{"_id": objectID}).Decode(&holiday)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrHolidayNotFound
+		}
+		return nil, err
+	}
+
+	return &holiday, nil
+}
+
+func (r *holidayRepository) Find(query domain.HolidayQuery) ([]*domain.Holiday, error) {
+	filter := bson.M{}
+	if query.Year != 0 {
+		from := time.Date(query.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(query.Year, time.December, 31, 0, 0, 0, 0, time.UTC)
+		filter = betweenFilter(from, to)
+	}
+	if query.Scope != "" {
+		filter["scope"] = query.Scope
+	}
+	if query.State != "" {
+		filter["state"] = query.State
+	}
+	if query.Court != "" {
+		filter["court"] = query.Court
+	}
+	return r.find(filter)
+}
+
+func (r *holidayRepository) FindBetween(from, to time.Time) ([]*domain.Holiday, error) {
+	return r.find(betweenFilter(from, to))
+}
+
+// betweenFilter seleciona os feriados que começam no período, os períodos que
+// o alcançam e todos os recorrentes
+func betweenFilter(from, to time.Time) bson.M {
+	return bson.M{"$or": bson.A{
+		bson.M{"date": bson.M{"$gte": from, "$lte": to}},
+		bson.M{"date": bson.M{"$lte": to}, "end_date": bson.M{"$gte": from}},
+		bson.M{"recurring": true},
+	}}
+}
+
+func (r *holidayRepository) find(filter bson.M) ([]*domain.Holiday, error) {
+	collection := r.db.Database.Collection(holidaysCollection)
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: 1}})
+	cursor, err := collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	holidays := []*domain.Holiday{}
+	if err = cursor.All(context.Background(), &holidays); err != nil {
+		return nil, err
+	}
+
+	return holidays, nil
+}
+
+func (r *holidayRepository) Update(holiday *domain.Holiday) error {
+	collection := r.db.Database.Collection(holidaysCollection)
+	result, err := collection.ReplaceOne(context.Background(), bson.M{"_id": holiday.ID}, holiday)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrDuplicateHoliday
+		}
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrHolidayNotFound
+	}
+	return nil
+}
+
+func (r *holidayRepository) Delete(id string) error {
+	collection := r.db.Database.Collection(holidaysCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := collection.DeleteOne(context.Background(), bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrHolidayNotFound
+	}
+
+	return nil
+}
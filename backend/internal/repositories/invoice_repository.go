@@ -0,0 +1,215 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	invoicesCollection = "invoices"
+
+	// countersCollection guarda as sequências numéricas da aplicação
+	countersCollection = "counters"
+)
+
+type invoiceRepository struct {
+	db *database.MongoDB
+}
+
+func NewInvoiceRepository(db *database.MongoDB) domain.InvoiceRepository {
+	err := db.EnsureIndexes(invoicesCollection,
+		// Rascunhos ainda não têm número
+		mongo.IndexModel{Keys: bson.D{{Key: "number", Value: 1}}, Options: options.Index().SetUnique(true).SetSparse(true)},
+		mongo.IndexModel{Keys: bson.D{{Key: "client_id", Value: 1}, {Key: "issue_date", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "status", Value: 1}, {Key: "due_date", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "case_id", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "pix_txid", Value: 1}}, Options: options.Index().SetUnique(true).SetSparse(true)},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &invoiceRepository{db: db}
+}
+
+func (r *invoiceRepository) Create(invoice *domain.Invoice) error {
+	collection := r.db.Database.Collection(invoicesCollection)
+
+	// O ID pode ser pré-definido para vincular os itens antes da gravação
+	if invoice.ID.IsZero() {
+		invoice.ID = primitive.NewObjectID()
+	}
+
+	_, err := collection.InsertOne(context.Background(), invoice)
+	return err
+}
+
+func (r *invoiceRepository) FindByID(id string) (*domain.Invoice, error) {
+	collection := r.db.Database.Collection(invoicesCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var invoice domain.Invoice
+	err = collection.FindOne(context.Background(), bson.M{"_id": objectID}).Decode(&invoice)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrInvoiceNotFound
+		}
+		return nil, err
+	}
+
+	return &invoice, nil
+}
+
+func (r *invoiceRepository) FindByPixTxID(txid string) (*domain.Invoice, error) {
+	collection := r.db.Database.Collection(invoicesCollection)
+
+	var invoice domain.Invoice
+	err := collection.FindOne(context.Background(), bson.M{"pix_txid": txid}).Decode(&invoice)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrInvoiceNotFound
+		}
+		return nil, err
+	}
+
+	return &invoice, nil
+}
+
+func (r *invoiceRepository) Find(query domain.InvoiceQuery) ([]*domain.Invoice, error) {
+	filter := bson.M{}
+	if query.ClientID != "" {
+		objectID, err := primitive.ObjectIDFromHex(query.ClientID)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		filter["client_id"] = objectID
+	}
+	if query.CaseID != "" {
+		objectID, err := primitive.ObjectIDFromHex(query.CaseID)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		filter["case_id"] = objectID
+	}
+	if query.Status != "" {
+		filter["status"] = query.Status
+	}
+	return r.find(filter, bson.D{{Key: "created_at", Value: -1}})
+}
+
+func (r *invoiceRepository) FindByClientIssuedBefore(clientID string, to time.Time) ([]*domain.Invoice, error) {
+	objectID, err := primitive.ObjectIDFromHex(clientID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	filter := bson.M{
+		"client_id":  objectID,
+		"issue_date": bson.M{"$lt": to},
+		"status":     bson.M{"$nin": bson.A{domain.InvoiceStatusDraft, domain.InvoiceStatusCancelled}},
+	}
+	return r.find(filter, bson.D{{Key: "issue_date", Value: 1}})
+}
+
+func (r *invoiceRepository) FindIssuedOrPaidBetween(from, to time.Time) ([]*domain.Invoice, error) {
+	period := bson.M{"$gte": from, "$lt": to}
+	filter := bson.M{
+		"status": bson.M{"$nin": bson.A{domain.InvoiceStatusDraft, domain.InvoiceStatusCancelled}},
+		"$or":    bson.A{bson.M{"issue_date": period}, bson.M{"paid_at": period}},
+	}
+	return r.find(filter, bson.D{{Key: "issue_date", Value: 1}})
+}
+
+func (r *invoiceRepository) find(filter bson.M, sort bson.D) ([]*domain.Invoice, error) {
+	collection := r.db.Database.Collection(invoicesCollection)
+	cursor, err := collection.Find(context.Background(), filter, options.Find().SetSort(sort))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	invoices := []*domain.Invoice{}
+	if err = cursor.All(context.Background(), &invoices); err != nil {
+		return nil, err
+	}
+
+	return invoices, nil
+}
+
+func (r *invoiceRepository) Update(invoice *domain.Invoice) error {
+	collection := r.db.Database.Collection(invoicesCollection)
+	result, err := collection.ReplaceOne(context.Background(), bson.M{"_id": invoice.ID}, invoice)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrInvoiceNotFound
+	}
+	return nil
+}
+
+func (r *invoiceRepository) Delete(id string) error {
+	collection := r.db.Database.Collection(invoicesCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := collection.DeleteOne(context.Background(), bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrInvoiceNotFound
+	}
+
+	return nil
+}
+
+// SetPixTxID grava o txid apenas se a fatura ainda não tiver um, sem
+// sobrescrever os demais campos
+func (r *invoiceRepository) SetPixTxID(id primitive.ObjectID, txid string) error {
+	collection := r.db.Database.Collection(invoicesCollection)
+	filter := bson.M{"_id": id, "pix_txid": bson.M{"$in": bson.A{nil, ""}}}
+	update := bson.M{"$set": bson.M{"pix_txid": txid, "updated_at": time.Now()}}
+	_, err := collection.UpdateOne(context.Background(), filter, update)
+	return err
+}
+
+func (r *invoiceRepository) MarkOverdue(before time.Time) error {
+	collection := r.db.Database.Collection(invoicesCollection)
+	filter := bson.M{"status": domain.InvoiceStatusIssued, "due_date": bson.M{"$lt": before}}
+	update := bson.M{"$set": bson.M{"status": domain.InvoiceStatusOverdue, "updated_at": time.Now()}}
+	_, err := collection.UpdateMany(context.Background(), filter, update)
+	return err
+}
+
+// NextNumber incrementa atomicamente a sequência do ano e retorna o número
+// no formato AAAA/NNNNNN
+func (r *invoiceRepository) NextNumber(year int) (string, error) {
+	collection := r.db.Database.Collection(countersCollection)
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var counter struct {
+		Seq int64 `bson:"seq"`
+	}
+	key := fmt.Sprintf("invoice_%d", year)
+	err := collection.FindOneAndUpdate(context.Background(), bson.M{"_id": key}, bson.M{"$inc": bson.M{"seq": 1}}, opts).Decode(&counter)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d/%06d", year, counter.Seq), nil
+}
@@ -0,0 +1,153 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const loginThrottlesCollection = "login_throttles"
+
+type loginThrottleRepository struct {
+	db *database.MongoDB
+}
+
+func NewLoginThrottleRepository(db *database.MongoDB) domain.LoginThrottleRepository {
+	err := db.EnsureIndexes(loginThrottlesCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "scope", Value: 1}, {Key: "subject", Value: 1}}, Options: options.Index().SetUnique(true)},
+		mongo.IndexModel{Keys: bson.D{{Key: "locked_until", Value: 1}}, Options: options.Index().SetSparse(true)},
+		// Descartar o histórico de contas e IPs sem falhas recentes
+		mongo.IndexModel{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &loginThrottleRepository{db: db}
+}
+
+func (r *loginThrottleRepository) Find(scope domain.LoginThrottleScope, subject string) (*domain.LoginThrottle, error) {
+	collection := r.db.Database.Collection(loginThrottlesCollection)
+
+	var throttle domain.LoginThrottle
+	err := collection.FindOne(context.Background(), bson.M{"scope": scope, "subject": subject}).Decode(&throttle)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrLoginThrottleNotFound
+		}
+		return nil, err
+	}
+
+	return &throttle, nil
+}
+
+func (r *loginThrottleRepository) RecordFailure(scope domain.LoginThrottleScope, subject string, now time.Time, window time.Duration, expiresAt time.Time) (*domain.LoginThrottle, error) {
+	collection := r.db.Database.Collection(loginThrottlesCollection)
+
+	// Pipeline de atualização para decidir sobre a janela no próprio banco,
+	// sem corrida entre instâncias; em um registro novo window_start não
+	// existe e a contagem começa em 1
+	inWindow := bson.M{"$gt": bson.A{"$window_start", now.Add(-window)}}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"failures":        bson.M{"$cond": bson.A{inWindow, bson.M{"$add": bson.A{"$failures", 1}}, 1}},
+		"window_start":    bson.M{"$cond": bson.A{inWindow, "$window_start", now}},
+		"lockouts":        bson.M{"$ifNull": bson.A{"$lockouts", 0}},
+		"last_failure_at": now,
+		"expires_at":      bson.M{"$max": bson.A{"$expires_at", expiresAt}},
+	}}}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	filter := bson.M{"scope": scope, "subject": subject}
+
+	var throttle domain.LoginThrottle
+	err := collection.FindOneAndUpdate(context.Background(), filter, update, opts).Decode(&throttle)
+	// Duas instâncias criando o mesmo registro: a segunda tentativa o encontra
+	if mongo.IsDuplicateKeyError(err) {
+		err = collection.FindOneAndUpdate(context.Background(), filter, update, opts).Decode(&throttle)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &throttle, nil
+}
+
+// Lock bloqueia até until e zera as falhas, contando mais um bloqueio
+func (r *loginThrottleRepository) Lock(id primitive.ObjectID, until time.Time, expiresAt time.Time) error {
+	collection := r.db.Database.Collection(loginThrottlesCollection)
+	update := bson.M{
+		"$set": bson.M{"locked_until": until, "failures": 0},
+		"$inc": bson.M{"lockouts": 1},
+		"$max": bson.M{"expires_at": expiresAt},
+	}
+
+	result, err := collection.UpdateOne(context.Background(), bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrLoginThrottleNotFound
+	}
+	return nil
+}
+
+// FindLocked lista as contas e IPs bloqueados no momento
+func (r *loginThrottleRepository) FindLocked(now time.Time) ([]*domain.LoginThrottle, error) {
+	collection := r.db.Database.Collection(loginThrottlesCollection)
+	opts := options.Find().SetSort(bson.D{{Key: "locked_until", Value: -1}})
+	cursor, err := collection.Find(context.Background(), bson.M{"locked_until": bson.M{"$gt": now}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	throttles := []*domain.LoginThrottle{}
+	if err = cursor.All(context.Background(), &throttles); err != nil {
+		return nil, err
+	}
+
+	return throttles, nil
+}
+
+// ResetFailures zera as falhas e encerra o bloqueio vigente, mantendo a
+// contagem de bloqueios, que só cai com a expiração do registro
+func (r *loginThrottleRepository) ResetFailures(scope domain.LoginThrottleScope, subject string) error {
+	collection := r.db.Database.Collection(loginThrottlesCollection)
+	update := bson.M{
+		"$set":   bson.M{"failures": 0},
+		"$unset": bson.M{"locked_until": ""},
+	}
+	_, err := collection.UpdateOne(context.Background(), bson.M{"scope": scope, "subject": subject}, update)
+	return err
+}
+
+// Reset descarta as falhas e bloqueios da conta ou IP
+func (r *loginThrottleRepository) Reset(scope domain.LoginThrottleScope, subject string) error {
+	collection := r.db.Database.Collection(loginThrottlesCollection)
+	_, err := collection.DeleteOne(context.Background(), bson.M{"scope": scope, "subject": subject})
+	return err
+}
+
+func (r *loginThrottleRepository) Delete(id string) error {
+	collection := r.db.Database.Collection(loginThrottlesCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := collection.DeleteOne(context.Background(), bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrLoginThrottleNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const passwordResetTokensCollection = "password_reset_tokens"
+
+type passwordResetTokenRepository struct {
+	db *database.MongoDB
+}
+
+func NewPasswordResetTokenRepository(db *database.MongoDB) domain.PasswordResetTokenRepository {
+	err := db.EnsureIndexes(passwordResetTokensCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "token_hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		mongo.IndexModel{Keys: bson.D{{Key: "user_id", Value: 1}}},
+		// Remover automaticamente tokens expirados
+		mongo.IndexModel{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &passwordResetTokenRepository{db: db}
+}
+
+func (r *passwordResetTokenRepository) Create(token *domain.PasswordResetToken) error {
+	collection := r.db.Database.Collection(passwordResetTokensCollection)
+	token.ID = primitive.NewObjectID()
+
+	_, err := collection.InsertOne(context.Background(), token)
+	return err
+}
+
+func (r *passwordResetTokenRepository) FindByHash(tokenHash string) (*domain.PasswordResetToken, error) {
+	collection := r.db.Database.Collection(passwordResetTokensCollection)
+
+	var token domain.PasswordResetToken
+	err := collection.FindOne(context.Background(), bson.M{"token_hash": tokenHash}).Decode(&token)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrPasswordResetTokenNotFound
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (r *passwordResetTokenRepository) MarkUsed(id primitive.ObjectID) error {
+	collection := r.db.Database.Collection(passwordResetTokensCollection)
+
+	// Só consome tokens ainda não usados, evitando corridas entre requisições
+	filter := bson.M{"_id": id, "used_at": bson.M{"$exists": false}}
+	result, err := collection.UpdateOne(context.Background(), filter, bson.M{"$set": bson.M{"used_at": time.Now()}})
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount == 0 {
+		return ErrPasswordResetTokenUsed
+	}
+
+	return nil
+}
+
+// DeleteByUser invalida os pedidos de redefinição pendentes do usuário
+func (r *passwordResetTokenRepository) DeleteByUser(userID string) error {
+	collection := r.db.Database.Collection(passwordResetTokensCollection)
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	_, err = collection.DeleteMany(context.Background(), bson.M{"user_id": objectID})
+	return err
+}
@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// caseBillingFields lista, por coleção, os campos que vinculam registros de
+// faturamento a um processo. Eles impedem a exclusão do processo para que as
+// faturas não apontem para registros inexistentes.
+var caseBillingFields = map[string][]string{
+	"time_entries":   {"case_id"},
+	"expenses":       {"case_id"},
+	"fee_agreements": {"case_id"},
+	"invoices":       {"case_id", "items.case_id"},
+}
+
+// clientReferenceFields lista os campos que vinculam registros a um cliente.
+// Processos contam mesmo na lixeira, pois seriam restaurados sem cliente.
+var clientReferenceFields = map[string][]string{
+	"cases":          {"client_id", "parties.client_id"},
+	"time_entries":   {"client_id"},
+	"expenses":       {"client_id"},
+	"fee_agreements": {"client_id"},
+	"invoices":       {"client_id"},
+}
+
+// caseRecordCollections guarda os registros que só existem em função do
+// processo e são removidos junto com ele
+var caseRecordCollections = []string{"deadlines", "events", "tasks", "calendar_feeds", "case_status_history"}
+
+type recordReferenceRepository struct {
+	db *database.MongoDB
+}
+
+func NewRecordReferenceRepository(db *database.MongoDB) domain.RecordReferenceRepository {
+	return &recordReferenceRepository{db: db}
+}
+
+func (r *recordReferenceRepository) CountCaseReferences(caseID string) (map[string]int64, error) {
+	return r.count(caseID, caseBillingFields)
+}
+
+func (r *recordReferenceRepository) CountClientReferences(clientID string) (map[string]int64, error) {
+	return r.count(clientID, clientReferenceFields)
+}
+
+// count retorna apenas as coleções com ao menos uma referência
+func (r *recordReferenceRepository) count(id string, referenceFields map[string][]string) (map[string]int64, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	references := make(map[string]int64)
+	for collectionName, fields := range referenceFields {
+		conditions := bson.A{}
+		for _, field := range fields {
+			conditions = append(conditions, bson.M{field: objectID})
+		}
+
+		collection := r.db.Database.Collection(collectionName)
+		count, err := collection.CountDocuments(context.Background(), bson.M{"$or": conditions})
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			references[collectionName] = count
+		}
+	}
+
+	return references, nil
+}
+
+func (r *recordReferenceRepository) PurgeCaseRecords(caseID string) error {
+	objectID, err := primitive.ObjectIDFromHex(caseID)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	for _, collectionName := range caseRecordCollections {
+		collection := r.db.Database.Collection(collectionName)
+		if _, err := collection.DeleteMany(context.Background(), bson.M{"case_id": objectID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const refreshTokensCollection = "refresh_tokens"
+
+type refreshTokenRepository struct {
+	db *database.MongoDB
+}
+
+func NewRefreshTokenRepository(db *database.MongoDB) domain.RefreshTokenRepository {
+	err := db.EnsureIndexes(refreshTokensCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "token_hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		mongo.IndexModel{Keys: bson.D{{Key: "family_id", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "user_id", Value: 1}}},
+		// Remover automaticamente tokens expirados
+		mongo.IndexModel{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &refreshTokenRepository{db: db}
+}
+
+func (r *refreshTokenRepository) Create(token *domain.RefreshToken) error {
+	collection := r.db.Database.Collection(refreshTokensCollection)
+
+	// O ID pode ser pré-definido durante a rotação para encadear os tokens
+	if token.ID.IsZero() {
+		token.ID = primitive.NewObjectID()
+	}
+
+	_, err := collection.InsertOne(context.Background(), token)
+	return err
+}
+
+func (r *refreshTokenRepository) FindByHash(hash string) (*domain.RefreshToken, error) {
+	collection := r.db.Database.Collection(refreshTokensCollection)
+
+	var token domain.RefreshToken
+	err := collection.FindOne(context.Background(), bson.M{"token_hash": hash}).Decode(&token)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrRefreshTokenNotFound
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (r *refreshTokenRepository) MarkRotated(id string, replacedBy primitive.ObjectID) error {
+	collection := r.db.Database.Collection(refreshTokensCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	// Só rotaciona tokens ainda não revogados, evitando corridas entre requisições
+	filter := bson.M{"_id": objectID, "revoked_at": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"revoked_at": time.Now(), "replaced_by": replacedBy}}
+
+	result, err := collection.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount == 0 {
+		return ErrRefreshTokenAlreadyRevoked
+	}
+
+	return nil
+}
+
+func (r *refreshTokenRepository) RevokeFamily(familyID string) error {
+	collection := r.db.Database.Collection(refreshTokensCollection)
+	filter := bson.M{"family_id": familyID, "revoked_at": bson.M{"$exists": false}}
+	_, err := collection.UpdateMany(context.Background(), filter, bson.M{"$set": bson.M{"revoked_at": time.Now()}})
+	return err
+}
+
+func (r *refreshTokenRepository) RevokeAllByUser(userID string) error {
+	collection := r.db.Database.Collection(refreshTokensCollection)
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{"user_id": objectID, "revoked_at": bson.M{"$exists": false}}
+	_, err = collection.UpdateMany(context.Background(), filter, bson.M{"$set": bson.M{"revoked_at": time.Now()}})
+	return err
+}
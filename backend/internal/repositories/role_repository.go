@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const rolesCollection = "roles"
+
+type roleRepository struct {
+	db *database.MongoDB
+}
+
+func NewRoleRepository(db *database.MongoDB) domain.RoleRepository {
+	err := db.EnsureIndexes(rolesCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "name", Value: 1}}, Options: options.Index().SetUnique(true)},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &roleRepository{db: db}
+}
+
+func (r *roleRepository) Create(role *domain.Role) error {
+	collection := r.db.Database.Collection(rolesCollection)
+
+	role.ID = primitive.NilObjectID
+
+	result, err := collection.InsertOne(context.Background(), role)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrDuplicateRole
+		}
+		return err
+	}
+
+	role.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *roleRepository) FindByName(name string) (*domain.Role, error) {
+	collection := r.db.Database.Collection(rolesCollection)
+
+	var role domain.Role
+	err := collection.FindOne(context.Background(), bson.M{"name": name}).Decode(&role)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrRoleNotFound
+		}
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+func (r *roleRepository) FindAll() ([]*domain.Role, error) {
+	collection := r.db.Database.Collection(rolesCollection)
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	cursor, err := collection.Find(context.Background(), bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	var roles []*domain.Role
+	if err = cursor.All(context.Background(), &roles); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (r *roleRepository) Update(role *domain.Role) error {
+	collection := r.db.Database.Collection(rolesCollection)
+	result, err := collection.ReplaceOne(context.Background(), bson.M{"_id": role.ID}, role)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrRoleNotFound
+	}
+	return nil
+}
+
+func (r *roleRepository) Delete(name string) error {
+	collection := r.db.Database.Collection(rolesCollection)
+	result, err := collection.DeleteOne(context.Background(), bson.M{"name": name})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrRoleNotFound
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const tasksCollection = "tasks"
+
+type taskRepository struct {
+	db *database.MongoDB
+}
+
+func NewTaskRepository(db *database.MongoDB) domain.TaskRepository {
+	err := db.EnsureIndexes(tasksCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "assignee_id", Value: 1}, {Key: "status", Value: 1}, {Key: "due_date", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "created_by", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "case_id", Value: 1}}},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &taskRepository{db: db}
+}
+
+func (r *taskRepository) Create(task *domain.Task) error {
+	collection := r.db.Database.Collection(tasksCollection)
+
+	// Garantir que o ID seja nulo para o MongoDB gerar
+	task.ID = primitive.NilObjectID
+
+	result, err := collection.InsertOne(context.Background(), task)
+	if err != nil {
+		return err
+	}
+
+	task.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *taskRepository) FindByID(id string) (*domain.Task, error) {
+	collection := r.db.Database.Collection(tasksCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var task domain.Task
+	err = collection.FindOne(context.Background(), bson.M{"_id": objectID}).Decode(&task)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, err
+	}
+
+	return &task, nil
+}
+
+// Find lista as tarefas por vencimento; tarefas sem vencimento aparecem por último
+func (r *taskRepository) Find(query domain.TaskQuery) ([]*domain.Task, error) {
+	filter := bson.M{}
+	if query.AssigneeIDs != nil {
+		filter["assignee_id"] = bson.M{"$in": query.AssigneeIDs}
+	}
+	if query.CreatedBy != "" {
+		objectID, err := primitive.ObjectIDFromHex(query.CreatedBy)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		filter["created_by"] = objectID
+	}
+	if query.CaseID != "" {
+		objectID, err := primitive.ObjectIDFromHex(query.CaseID)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		filter["case_id"] = objectID
+	}
+	if query.Priority != "" {
+		filter["priority"] = query.Priority
+	}
+	if query.Status != "" {
+		filter["status"] = query.Status
+	}
+	if !query.DueBefore.IsZero() {
+		filter["due_date"] = bson.M{"$lt": query.DueBefore}
+		if query.Status == "" {
+			filter["status"] = bson.M{"$in": domain.OpenTaskStatuses}
+		}
+	}
+
+	collection := r.db.Database.Collection(tasksCollection)
+	cursor, err := collection.Find(context.Background(), filter,
+		options.Find().SetSort(bson.D{{Key: "due_date", Value: 1}, {Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	tasks := []*domain.Task{}
+	if err = cursor.All(context.Background(), &tasks); err != nil {
+		return nil, err
+	}
+
+	// O MongoDB ordena vencimentos ausentes antes dos demais
+	withDue := make([]*domain.Task, 0, len(tasks))
+	withoutDue := []*domain.Task{}
+	for _, task := range tasks {
+		if task.DueDate == nil {
+			withoutDue = append(withoutDue, task)
+		} else {
+			withDue = append(withDue, task)
+		}
+	}
+
+	return append(withDue, withoutDue...), nil
+}
+
+func (r *taskRepository) Update(task *domain.Task) error {
+	collection := r.db.Database.Collection(tasksCollection)
+	result, err := collection.ReplaceOne(context.Background(), bson.M{"_id": task.ID}, task)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrTaskNotFound
+	}
+	return nil
+}
+
+// AddComment inclui o comentário sem regravar a tarefa, evitando perder
+// alterações concorrentes
+func (r *taskRepository) AddComment(taskID string, comment domain.TaskComment) error {
+	collection := r.db.Database.Collection(tasksCollection)
+	objectID, err := primitive.ObjectIDFromHex(taskID)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	update := bson.M{
+		"$push": bson.M{"comments": comment},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+	result, err := collection.UpdateOne(context.Background(), bson.M{"_id": objectID}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrTaskNotFound
+	}
+	return nil
+}
+
+func (r *taskRepository) Delete(id string) error {
+	collection := r.db.Database.Collection(tasksCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := collection.DeleteOne(context.Background(), bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrTaskNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const timeEntriesCollection = "time_entries"
+
+type timeEntryRepository struct {
+	db *database.MongoDB
+}
+
+func NewTimeEntryRepository(db *database.MongoDB) domain.TimeEntryRepository {
+	err := db.EnsureIndexes(timeEntriesCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "date", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "case_id", Value: 1}, {Key: "date", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "client_id", Value: 1}, {Key: "invoice_id", Value: 1}}},
+		// No máximo um cronômetro em andamento por usuário
+		mongo.IndexModel{
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"running": true}).SetName("user_running_timer"),
+		},
+	)
+	if err != nil {
+		log.Printf("Aviso: %v", err)
+	}
+	return &timeEntryRepository{db: db}
+}
+
+func (r *timeEntryRepository) Create(entry *domain.TimeEntry) error {
+	collection := r.db.Database.Collection(timeEntriesCollection)
+
+	// Garantir que o ID seja nulo para o MongoDB gerar
+	entry.ID = primitive.NilObjectID
+
+	result, err := collection.InsertOne(context.Background(), entry)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrTimerAlreadyRunning
+		}
+		return err
+	}
+
+	entry.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *timeEntryRepository) FindByID(id string) (*domain.TimeEntry, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	return r.findOne(bson.M{"_id": objectID})
+}
+
+// FindRunning retorna o cronômetro em andamento do usuário
+func (r *timeEntryRepository) FindRunning(userID string) (*domain.TimeEntry, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	return r.findOne(bson.M{"user_id": objectID, "running": true})
+}
+
+func (r *timeEntryRepository) findOne(filter bson.M) (*domain.TimeEntry, error) {
+	collection := r.db.Database.Collection(timeEntriesCollection)
+
+	var entry domain.TimeEntry
+	err := collection.FindOne(context.Background(), filter).Decode(&entry)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrTimeEntryNotFound
+		}
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func (r *timeEntryRepository) Find(query domain.TimeEntryQuery) ([]*domain.TimeEntry, error) {
+	filter := bson.M{}
+	if query.UserID != "" {
+		objectID, err := primitive.ObjectIDFromHex(query.UserID)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		filter["user_id"] = objectID
+	}
+	if query.CaseID != "" {
+		objectID, err := primitive.ObjectIDFromHex(query.CaseID)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		filter["case_id"] = objectID
+	}
+	if query.ClientID != "" {
+		objectID, err := primitive.ObjectIDFromHex(query.ClientID)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		filter["client_id"] = objectID
+	}
+	if query.Unbilled {
+		filter["billable"] = true
+		filter["running"] = false
+		filter["invoice_id"] = bson.M{"$exists": false}
+	}
+	date := bson.M{}
+	if !query.From.IsZero() {
+		date["$gte"] = query.From
+	}
+	if !query.To.IsZero() {
+		date["$lt"] = query.To
+	}
+	if len(date) > 0 {
+		filter["date"] = date
+	}
+	if query.Billable != nil && !query.Unbilled {
+		filter["billable"] = *query.Billable
+	}
+
+	collection := r.db.Database.Collection(timeEntriesCollection)
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: 1}, {Key: "created_at", Value: 1}})
+	cursor, err := collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	entries := []*domain.TimeEntry{}
+	if err = cursor.All(context.Background(), &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (r *timeEntryRepository) Update(entry *domain.TimeEntry) error {
+	collection := r.db.Database.Collection(timeEntriesCollection)
+	result, err := collection.ReplaceOne(context.Background(), bson.M{"_id": entry.ID}, entry)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrTimerAlreadyRunning
+		}
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrTimeEntryNotFound
+	}
+	return nil
+}
+
+func (r *timeEntryRepository) Delete(id string) error {
+	collection := r.db.Database.Collection(timeEntriesCollection)
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := collection.DeleteOne(context.Background(), bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrTimeEntryNotFound
+	}
+
+	return nil
+}
+
+func (r *timeEntryRepository) SetInvoice(ids []primitive.ObjectID, invoiceID primitive.ObjectID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	collection := r.db.Database.Collection(timeEntriesCollection)
+	// Só vincula os que continuam livres, para não tirá-los de outra fatura
+	filter := bson.M{"_id": bson.M{"$in": ids}, "invoice_id": bson.M{"$exists": false}}
+	result, err := collection.UpdateMany(context.Background(), filter, bson.M{"$set": bson.M{"invoice_id": invoiceID}})
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount != int64(len(ids)) {
+		return ErrAlreadyInvoiced
+	}
+	return nil
+}
+
+func (r *timeEntryRepository) ReleaseInvoice(invoiceID primitive.ObjectID) error {
+	collection := r.db.Database.Collection(timeEntriesCollection)
+	_, err := collection.UpdateMany(context.Background(), bson.M{"invoice_id": invoiceID}, bson.M{"$unset": bson.M{"invoice_id": ""}})
+	return err
+}
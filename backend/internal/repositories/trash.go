@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// notDeleted restringe o filtro aos registros fora da lixeira
+func notDeleted(filter bson.M) bson.M {
+	filter["deleted_at"] = bson.M{"$exists": false}
+	return filter
+}
+
+// moveToTrash marca o registro como excluído, mantendo-o no banco
+func moveToTrash(collection *mongo.Collection, id string, deletedBy primitive.ObjectID, notFound error) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	now := time.Now()
+	update := bson.M{"$set": bson.M{"deleted_at": now, "deleted_by": deletedBy, "updated_at": now}}
+	result, err := collection.UpdateOne(context.Background(), notDeleted(bson.M{"_id": objectID}), update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return notFound
+	}
+	return nil
+}
+
+// restoreFromTrash devolve o registro excluído às buscas
+func restoreFromTrash(collection *mongo.Collection, id string, notFound error) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	filter := bson.M{"_id": objectID, "deleted_at": bson.M{"$exists": true}}
+	update := bson.M{
+		"$unset": bson.M{"deleted_at": "", "deleted_by": ""},
+		"$set":   bson.M{"updated_at": time.Now()},
+	}
+	result, err := collection.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return notFound
+	}
+	return nil
+}
+
+// findDeleted lista os registros da lixeira, dos excluídos mais recentemente
+// aos mais antigos; deletedBefore, se informado, limita aos excluídos antes dele
+func findDeleted(collection *mongo.Collection, deletedBefore time.Time, results interface{}) error {
+	deletedAt := bson.M{"$exists": true}
+	if !deletedBefore.IsZero() {
+		deletedAt["$lt"] = deletedBefore
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "deleted_at", Value: -1}})
+	cursor, err := collection.Find(context.Background(), bson.M{"deleted_at": deletedAt}, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(context.Background())
+
+	return cursor.All(context.Background(), results)
+}
+
+// findDeletedByID carrega um registro da lixeira
+func findDeletedByID(collection *mongo.Collection, id string, notFound error, result interface{}) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	filter := bson.M{"_id": objectID, "deleted_at": bson.M{"$exists": true}}
+	if err := collection.FindOne(context.Background(), filter).Decode(result); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return notFound
+		}
+		return err
+	}
+	return nil
+}
+
+// purge remove definitivamente o registro, esteja ou não na lixeira
+func purge(collection *mongo.Collection, id string, notFound error) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+
+	result, err := collection.DeleteOne(context.Background(), bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return notFound
+	}
+	return nil
+}
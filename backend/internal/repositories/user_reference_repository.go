@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/jurisconnect/backend/internal/database"
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// userReferenceFields lista, por coleção, os campos que guardam o ID de um
+// usuário. Sessões (refresh_tokens) e assinaturas de agenda pertencem ao
+// próprio usuário e não impedem a exclusão.
+var userReferenceFields = map[string][]string{
+	"users":               {"professional_info.supervisor_id"},
+	"clients":             {"created_by", "conflict_override.acknowledged_by"},
+	"cases":               {"lawyer_id", "team.user_id", "created_by", "conflict_override.acknowledged_by"},
+	"case_status_history": {"changed_by"},
+	"conflict_checks":     {"checked_by"},
+	"documents":           {"created_by"},
+	"document_versions":   {"created_by"},
+	"deadlines":           {"assigned_to", "created_by"},
+	"holidays":            {"created_by"},
+	"events":              {"lawyer_id", "participants", "created_by"},
+	"tasks":               {"assignee_id", "created_by", "comments.author_id", "checklist.done_by"},
+	"time_entries":        {"user_id"},
+	"expenses":            {"created_by", "approver_id", "reviewed_by"},
+	"fee_agreements":      {"created_by"},
+	"invoices":            {"created_by"},
+}
+
+type userReferenceRepository struct {
+	db *database.MongoDB
+}
+
+func NewUserReferenceRepository(db *database.MongoDB) domain.UserReferenceRepository {
+	return &userReferenceRepository{db: db}
+}
+
+// CountReferences retorna apenas as coleções com ao menos uma referência
+func (r *userReferenceRepository) CountReferences(userID string) (map[string]int64, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	references := make(map[string]int64)
+	for collectionName, fields := range userReferenceFields {
+		conditions := bson.A{}
+		for _, field := range fields {
+			conditions = append(conditions, bson.M{field: objectID})
+		}
+
+		collection := r.db.Database.Collection(collectionName)
+		count, err := collection.CountDocuments(context.Background(), bson.M{"$or": conditions})
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			references[collectionName] = count
+		}
+	}
+
+	return references, nil
+}
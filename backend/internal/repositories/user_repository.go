@@ -3,7 +3,6 @@ package repositories
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
 	"github.com/jurisconnect/backend/internal/database"
@@ -45,7 +44,7 @@ func (r *userRepository) FindByID(id string) (*domain.User, error) {
 	}
 
 	var user domain.User
-	err = collection.FindOne(context.Background(), bson.M{"_id": objectID}).Decode(&user)
+	err = collection.FindOne(context.Background(), notDeleted(bson.M{"_id": objectID})).Decode(&user)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, ErrUserNotFound
@@ -59,7 +58,7 @@ func (r *userRepository) FindByID(id string) (*domain.User, error) {
 func (r *userRepository) FindByEmail(email string) (*domain.User, error) {
 	collection := r.db.Database.Collection("users")
 	var user domain.User
-	err := collection.FindOne(context.Background(), bson.M{"personal_info.email": email}).Decode(&user)
+	err := collection.FindOne(context.Background(), notDeleted(bson.M{"personal_info.email": email})).Decode(&user)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, ErrUserNotFound
@@ -72,37 +71,26 @@ func (r *userRepository) FindByEmail(email string) (*domain.User, error) {
 
 func (r *userRepository) FindByOAB(oabNumber, oabState string) (*domain.User, error) {
 	collection := r.db.Database.Collection("users")
-
-	// Log dos parâmetros recebidos
-	fmt.Printf("Buscando usuário com OAB: %s/%s\n", oabNumber, oabState)
-
-	// Construir a query
-	query := bson.M{
+	query := notDeleted(bson.M{
 		"professional_info.oab_number": oabNumber,
 		"professional_info.oab_state":  oabState,
-	}
-
-	// Log da query
-	fmt.Printf("Query: %+v\n", query)
+	})
 
 	var user domain.User
 	err := collection.FindOne(context.Background(), query).Decode(&user)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			fmt.Printf("Usuário não encontrado com OAB: %s/%s\n", oabNumber, oabState)
 			return nil, ErrUserNotFound
 		}
-		fmt.Printf("Erro ao buscar usuário: %v\n", err)
 		return nil, err
 	}
 
-	fmt.Printf("Usuário encontrado: %+v\n", user)
 	return &user, nil
 }
 
 func (r *userRepository) FindByDepartment(department string) ([]*domain.User, error) {
 	collection := r.db.Database.Collection("users")
-	cursor, err := collection.Find(context.Background(), bson.M{"professional_info.department": department})
+	cursor, err := collection.Find(context.Background(), notDeleted(bson.M{"professional_info.department": department}))
 	if err != nil {
 		return nil, err
 	}
@@ -116,33 +104,80 @@ func (r *userRepository) FindByDepartment(department string) ([]*domain.User, er
 	return users, nil
 }
 
+// FindBySupervisorID lista os usuários subordinados ao supervisor
+func (r *userRepository) FindBySupervisorID(supervisorID string) ([]*domain.User, error) {
+	collection := r.db.Database.Collection("users")
+	objectID, err := primitive.ObjectIDFromHex(supervisorID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	cursor, err := collection.Find(context.Background(), notDeleted(bson.M{"professional_info.supervisor_id": objectID}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	users := []*domain.User{}
+	if err = cursor.All(context.Background(), &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (r *userRepository) CountByRole(role string) (int64, error) {
+	collection := r.db.Database.Collection("users")
+	return collection.CountDocuments(context.Background(), notDeleted(bson.M{"role": role}))
+}
+
+func (r *userRepository) CountActiveByRole(role string) (int64, error) {
+	collection := r.db.Database.Collection("users")
+	return collection.CountDocuments(context.Background(), notDeleted(bson.M{"role": role, "is_active": true}))
+}
+
 func (r *userRepository) Update(user *domain.User) error {
 	collection := r.db.Database.Collection("users")
-	_, err := collection.ReplaceOne(context.Background(), bson.M{"_id": user.ID}, user)
+	// A geração de sessões só muda por RevokeTokens: a substituição mantém o
+	// valor gravado, para que um documento desatualizado não reative tokens
+	update := mongo.Pipeline{{{Key: "$replaceWith", Value: bson.M{
+		"$mergeObjects": bson.A{
+			bson.M{"$literal": user},
+			bson.M{"token_generation": "$token_generation"},
+		},
+	}}}}
+	_, err := collection.UpdateOne(context.Background(), notDeleted(bson.M{"_id": user.ID}), update)
 	return err
 }
 
-func (r *userRepository) Delete(id string) error {
-	collection := r.db.Database.Collection("users")
+// Delete move o usuário para a lixeira
+func (r *userRepository) Delete(id string, deletedBy primitive.ObjectID) error {
+	return moveToTrash(r.db.Database.Collection("users"), id, deletedBy, ErrUserNotFound)
+}
 
-	// Converter o ID string para ObjectID
-	objectID, err := primitive.ObjectIDFromHex(id)
-	if err != nil {
-		return err
-	}
+func (r *userRepository) Restore(id string) error {
+	return restoreFromTrash(r.db.Database.Collection("users"), id, ErrUserNotFound)
+}
 
-	// Deletar o documento usando o _id
-	result, err := collection.DeleteOne(context.Background(), bson.M{"_id": objectID})
-	if err != nil {
-		return err
+func (r *userRepository) FindDeleted(deletedBefore time.Time) ([]*domain.User, error) {
+	users := []*domain.User{}
+	if err := findDeleted(r.db.Database.Collection("users"), deletedBefore, &users); err != nil {
+		return nil, err
 	}
+	return users, nil
+}
 
-	// Verificar se algum documento foi deletado
-	if result.DeletedCount == 0 {
-		return ErrUserNotFound
+func (r *userRepository) FindDeletedByID(id string) (*domain.User, error) {
+	var user domain.User
+	if err := findDeletedByID(r.db.Database.Collection("users"), id, ErrUserNotFound, &user); err != nil {
+		return nil, err
 	}
+	return &user, nil
+}
 
-	return nil
+// Purge remove o usuário definitivamente
+func (r *userRepository) Purge(id string) error {
+	return purge(r.db.Database.Collection("users"), id, ErrUserNotFound)
 }
 
 func (r *userRepository) UpdateLastLogin(id string) error {
@@ -162,3 +197,26 @@ func (r *userRepository) UpdateLastLogin(id string) error {
 	_, err = collection.UpdateOne(context.Background(), bson.M{"_id": objectID}, update)
 	return err
 }
+
+func (r *userRepository) RevokeTokens(id string) error {
+	collection := r.db.Database.Collection("users")
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$inc": bson.M{"token_generation": 1},
+		"$set": bson.M{"updated_at": time.Now()},
+	}
+
+	result, err := collection.UpdateOne(context.Background(), bson.M{"_id": objectID}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
@@ -2,26 +2,199 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/jurisconnect/backend/internal/domain"
 	"github.com/jurisconnect/backend/internal/handlers"
+	"github.com/jurisconnect/backend/internal/middleware"
 )
 
-func SetupRoutes(router *gin.Engine, userHandler *handlers.UserHandler) {
+// Handlers agrupa os handlers HTTP registrados nas rotas da API
+type Handlers struct {
+	User     *handlers.UserHandler
+	Auth     *handlers.AuthHandler
+	Role     *handlers.RoleHandler
+	Case     *handlers.CaseHandler
+	Document *handlers.DocumentHandler
+	Client   *handlers.ClientHandler
+	Conflict *handlers.ConflictHandler
+	Deadline *handlers.DeadlineHandler
+	Holiday  *handlers.HolidayHandler
+	Event    *handlers.EventHandler
+	Feed     *handlers.CalendarFeedHandler
+	Task     *handlers.TaskHandler
+	Time     *handlers.TimeEntryHandler
+	Fee      *handlers.FeeAgreementHandler
+	Invoice  *handlers.InvoiceHandler
+	Pix      *handlers.PixHandler
+	Expense  *handlers.ExpenseHandler
+	Trash    *handlers.TrashHandler
+	Password *handlers.PasswordHandler
+}
+
+func SetupRoutes(router *gin.Engine, h Handlers, authMiddleware gin.HandlerFunc, authz *middleware.Authorizer) {
 	// Rotas públicas
 	public := router.Group("/api")
 	{
-		public.POST("/login", userHandler.Login)
+		public.POST("/login", h.User.Login)
+		public.POST("/auth/refresh", h.Auth.Refresh)
+		public.POST("/auth/logout", h.Auth.Logout)
+		public.POST("/auth/forgot-password", h.Password.ForgotPassword)
+		public.POST("/auth/reset-password", h.Password.ResetPassword)
+
+		// Assinaturas iCalendar autenticadas pelo token da própria URL
+		public.GET("/feeds/:token", h.Feed.Feed)
+	}
+
+	// Conta do próprio usuário autenticado, liberada mesmo com a troca de
+	// senha pendente
+	account := router.Group("/api")
+	account.Use(authMiddleware)
+	{
+		account.POST("/auth/change-password", h.Password.ChangePassword)
+		account.POST("/auth/logout-all", h.Auth.LogoutAll)
 	}
 
 	// Rotas protegidas
 	protected := router.Group("/api")
-	// TODO: Adicionar middleware de autenticação
+	protected.Use(authMiddleware, middleware.RequirePasswordChanged())
 	{
-		protected.POST("/users", userHandler.Create)
-		protected.GET("/users/:id", userHandler.GetByID)
-		protected.GET("/users/email/:email", userHandler.GetByEmail)
-		protected.GET("/users/oab/:number/:state", userHandler.GetByOAB)
-		protected.GET("/users/department/:department", userHandler.GetByDepartment)
-		protected.PUT("/users/:id", userHandler.Update)
-		protected.DELETE("/users/:id", userHandler.DeleteUser)
+		protected.POST("/users", authz.RequirePermission(domain.ModuleUsers, domain.ActionCreate), h.User.Create)
+		protected.GET("/users/:id", authz.RequirePermissionOrSelf(domain.ModuleUsers, domain.ActionRead, "id"), h.User.GetByID)
+		protected.GET("/users/email/:email", authz.RequirePermission(domain.ModuleUsers, domain.ActionRead), h.User.GetByEmail)
+		protected.GET("/users/oab/:number/:state", authz.RequirePermission(domain.ModuleUsers, domain.ActionRead), h.User.GetByOAB)
+		protected.GET("/users/department/:department", authz.RequirePermission(domain.ModuleUsers, domain.ActionRead), h.User.GetByDepartment)
+		protected.PUT("/users/:id", authz.RequirePermissionOrSelf(domain.ModuleUsers, domain.ActionUpdate, "id"), h.User.Update)
+		protected.DELETE("/users/:id", authz.RequirePermission(domain.ModuleUsers, domain.ActionDelete), h.User.DeleteUser)
+		protected.POST("/users/:id/deactivate", authz.RequirePermission(domain.ModuleUsers, domain.ActionUpdate), h.User.Deactivate)
+		protected.POST("/users/:id/reactivate", authz.RequirePermission(domain.ModuleUsers, domain.ActionUpdate), h.User.Reactivate)
+		protected.POST("/users/:id/reassign", authz.RequirePermission(domain.ModuleUsers, domain.ActionUpdate), h.User.Reassign)
+		protected.DELETE("/users/:id/sessions", authz.RequirePermission(domain.ModuleUsers, domain.ActionUpdate), h.Auth.RevokeUserSessions)
+		protected.POST("/users/:id/reset-password", authz.RequirePermission(domain.ModuleUsers, domain.ActionUpdate), h.Password.ResetByAdmin)
+		protected.POST("/users/:id/unlock", authz.RequirePermission(domain.ModuleUsers, domain.ActionUpdate), h.User.Unlock)
+		protected.GET("/login-locks", authz.RequirePermission(domain.ModuleUsers, domain.ActionRead), h.User.GetLoginLocks)
+		protected.DELETE("/login-locks/:id", authz.RequirePermission(domain.ModuleUsers, domain.ActionUpdate), h.User.DeleteLoginLock)
+
+		protected.POST("/roles", authz.RequirePermission(domain.ModuleRoles, domain.ActionCreate), h.Role.Create)
+		protected.GET("/roles", authz.RequirePermission(domain.ModuleRoles, domain.ActionRead), h.Role.List)
+		protected.GET("/roles/:name", authz.RequirePermission(domain.ModuleRoles, domain.ActionRead), h.Role.GetByName)
+		protected.PUT("/roles/:name", authz.RequirePermission(domain.ModuleRoles, domain.ActionUpdate), h.Role.Update)
+		protected.DELETE("/roles/:name", authz.RequirePermission(domain.ModuleRoles, domain.ActionDelete), h.Role.Delete)
+
+		protected.POST("/clients", authz.RequirePermission(domain.ModuleClients, domain.ActionCreate), h.Client.Create)
+		protected.GET("/clients", authz.RequirePermission(domain.ModuleClients, domain.ActionRead), h.Client.Search)
+		protected.GET("/clients/:id", authz.RequirePermission(domain.ModuleClients, domain.ActionRead), h.Client.GetByID)
+		protected.GET("/clients/document/:document", authz.RequirePermission(domain.ModuleClients, domain.ActionRead), h.Client.GetByDocument)
+		protected.PUT("/clients/:id", authz.RequirePermission(domain.ModuleClients, domain.ActionUpdate), h.Client.Update)
+		protected.DELETE("/clients/:id", authz.RequirePermission(domain.ModuleClients, domain.ActionDelete), h.Client.Delete)
+
+		protected.POST("/conflicts/check", authz.RequirePermission(domain.ModuleClients, domain.ActionRead), h.Conflict.Check)
+		protected.GET("/conflicts/overrides", authz.RequirePermission(domain.ModuleReports, domain.ActionRead), h.Conflict.ListOverrides)
+
+		protected.POST("/cases", authz.RequirePermission(domain.ModuleCases, domain.ActionCreate), h.Case.Create)
+		protected.GET("/cases/:id", authz.RequirePermission(domain.ModuleCases, domain.ActionRead), h.Case.GetByID)
+		protected.GET("/cases/number/:number", authz.RequirePermission(domain.ModuleCases, domain.ActionRead), h.Case.GetByNumber)
+		protected.GET("/cases/client/:clientId", authz.RequirePermission(domain.ModuleCases, domain.ActionRead), h.Case.GetByClientID)
+		protected.GET("/cases/lawyer/:lawyerId", authz.RequirePermission(domain.ModuleCases, domain.ActionRead), h.Case.GetByLawyerID)
+		protected.GET("/cases/party", authz.RequirePermission(domain.ModuleCases, domain.ActionRead), h.Case.GetByParty)
+		protected.GET("/cases/team/:userId", authz.RequirePermission(domain.ModuleCases, domain.ActionRead), h.Case.GetByTeamMember)
+		protected.PUT("/cases/:id", authz.RequirePermission(domain.ModuleCases, domain.ActionUpdate), h.Case.Update)
+		protected.POST("/cases/:id/status", authz.RequirePermission(domain.ModuleCases, domain.ActionUpdate), h.Case.TransitionStatus)
+		protected.GET("/cases/:id/status-history", authz.RequirePermission(domain.ModuleCases, domain.ActionRead), h.Case.GetStatusHistory)
+		protected.DELETE("/cases/:id", authz.RequirePermission(domain.ModuleCases, domain.ActionDelete), h.Case.Delete)
+
+		protected.POST("/holidays", authz.RequirePermission(domain.ModuleCalendar, domain.ActionCreate), h.Holiday.Create)
+		protected.POST("/holidays/import", authz.RequirePermission(domain.ModuleCalendar, domain.ActionCreate), h.Holiday.Import)
+		protected.GET("/holidays", authz.RequirePermission(domain.ModuleCalendar, domain.ActionRead), h.Holiday.List)
+		protected.GET("/holidays/:id", authz.RequirePermission(domain.ModuleCalendar, domain.ActionRead), h.Holiday.GetByID)
+		protected.PUT("/holidays/:id", authz.RequirePermission(domain.ModuleCalendar, domain.ActionUpdate), h.Holiday.Update)
+		protected.DELETE("/holidays/:id", authz.RequirePermission(domain.ModuleCalendar, domain.ActionDelete), h.Holiday.Delete)
+		protected.GET("/calendar/working-day", authz.RequirePermission(domain.ModuleCalendar, domain.ActionRead), h.Holiday.WorkingDay)
+		protected.GET("/calendar/non-working-days", authz.RequirePermission(domain.ModuleCalendar, domain.ActionRead), h.Holiday.NonWorkingDays)
+
+		protected.POST("/deadlines/calculate", authz.RequirePermission(domain.ModuleDeadlines, domain.ActionRead), h.Deadline.Calculate)
+		protected.POST("/cases/:id/deadlines", authz.RequirePermission(domain.ModuleDeadlines, domain.ActionCreate), h.Deadline.Create)
+		protected.GET("/cases/:id/deadlines", authz.RequirePermission(domain.ModuleDeadlines, domain.ActionRead), h.Deadline.GetByCaseID)
+		protected.GET("/cases/:id/deadlines/:deadlineId", authz.RequirePermission(domain.ModuleDeadlines, domain.ActionRead), h.Deadline.GetByID)
+		protected.PUT("/cases/:id/deadlines/:deadlineId", authz.RequirePermission(domain.ModuleDeadlines, domain.ActionUpdate), h.Deadline.Update)
+		protected.DELETE("/cases/:id/deadlines/:deadlineId", authz.RequirePermission(domain.ModuleDeadlines, domain.ActionDelete), h.Deadline.Delete)
+
+		protected.POST("/events", authz.RequirePermission(domain.ModuleEvents, domain.ActionCreate), h.Event.Create)
+		protected.GET("/events", authz.RequirePermission(domain.ModuleEvents, domain.ActionRead), h.Event.List)
+		protected.GET("/events/:id", authz.RequirePermission(domain.ModuleEvents, domain.ActionRead), h.Event.GetByID)
+		protected.PUT("/events/:id", authz.RequirePermission(domain.ModuleEvents, domain.ActionUpdate), h.Event.Update)
+		protected.DELETE("/events/:id", authz.RequirePermission(domain.ModuleEvents, domain.ActionDelete), h.Event.Delete)
+		protected.POST("/calendar/feeds", authz.RequirePermission(domain.ModuleEvents, domain.ActionRead), h.Feed.Create)
+		protected.GET("/calendar/feeds", authz.RequirePermission(domain.ModuleEvents, domain.ActionRead), h.Feed.List)
+		protected.DELETE("/calendar/feeds", authz.RequirePermission(domain.ModuleEvents, domain.ActionRead), h.Feed.RevokeAll)
+		protected.DELETE("/calendar/feeds/:id", authz.RequirePermission(domain.ModuleEvents, domain.ActionRead), h.Feed.Revoke)
+		protected.GET("/cases/:id/events", authz.RequirePermission(domain.ModuleEvents, domain.ActionRead), h.Event.GetByCaseID)
+
+		protected.POST("/tasks", authz.RequirePermission(domain.ModuleTasks, domain.ActionCreate), h.Task.Create)
+		protected.GET("/tasks", authz.RequirePermission(domain.ModuleTasks, domain.ActionRead), h.Task.List)
+		protected.GET("/tasks/mine", authz.RequirePermission(domain.ModuleTasks, domain.ActionRead), h.Task.GetMine)
+		protected.GET("/tasks/delegated", authz.RequirePermission(domain.ModuleTasks, domain.ActionRead), h.Task.GetDelegated)
+		protected.GET("/tasks/overdue", authz.RequirePermission(domain.ModuleTasks, domain.ActionRead), h.Task.GetOverdue)
+		protected.GET("/tasks/:id", authz.RequirePermission(domain.ModuleTasks, domain.ActionRead), h.Task.GetByID)
+		protected.PUT("/tasks/:id", authz.RequirePermission(domain.ModuleTasks, domain.ActionUpdate), h.Task.Update)
+		protected.PUT("/tasks/:id/checklist/:itemId", authz.RequirePermission(domain.ModuleTasks, domain.ActionUpdate), h.Task.UpdateChecklistItem)
+		protected.POST("/tasks/:id/comments", authz.RequirePermission(domain.ModuleTasks, domain.ActionUpdate), h.Task.AddComment)
+		protected.DELETE("/tasks/:id", authz.RequirePermission(domain.ModuleTasks, domain.ActionDelete), h.Task.Delete)
+
+		protected.POST("/time-entries", authz.RequirePermission(domain.ModuleTimesheet, domain.ActionCreate), h.Time.Create)
+		protected.GET("/time-entries", authz.RequirePermission(domain.ModuleTimesheet, domain.ActionRead), h.Time.List)
+		protected.GET("/time-entries/:id", authz.RequirePermission(domain.ModuleTimesheet, domain.ActionRead), h.Time.GetByID)
+		protected.PUT("/time-entries/:id", authz.RequirePermission(domain.ModuleTimesheet, domain.ActionUpdate), h.Time.Update)
+		protected.DELETE("/time-entries/:id", authz.RequirePermission(domain.ModuleTimesheet, domain.ActionDelete), h.Time.Delete)
+		protected.GET("/timer", authz.RequirePermission(domain.ModuleTimesheet, domain.ActionRead), h.Time.GetTimer)
+		protected.POST("/timer/start", authz.RequirePermission(domain.ModuleTimesheet, domain.ActionCreate), h.Time.StartTimer)
+		protected.POST("/timer/stop", authz.RequirePermission(domain.ModuleTimesheet, domain.ActionCreate), h.Time.StopTimer)
+		protected.GET("/timesheets/weekly", authz.RequirePermission(domain.ModuleTimesheet, domain.ActionRead), h.Time.WeeklyByUser)
+		protected.GET("/cases/:id/timesheet", authz.RequirePermission(domain.ModuleTimesheet, domain.ActionRead), h.Time.WeeklyByCase)
+
+		protected.POST("/expenses", authz.RequirePermission(domain.ModuleExpenses, domain.ActionCreate), h.Expense.Create)
+		protected.GET("/expenses", authz.RequirePermission(domain.ModuleExpenses, domain.ActionRead), h.Expense.List)
+		protected.GET("/expenses/pending-approval", authz.RequirePermission(domain.ModuleExpenses, domain.ActionUpdate), h.Expense.GetPendingApproval)
+		protected.GET("/expenses/:id", authz.RequirePermission(domain.ModuleExpenses, domain.ActionRead), h.Expense.GetByID)
+		protected.PUT("/expenses/:id", authz.RequirePermission(domain.ModuleExpenses, domain.ActionUpdate), h.Expense.Update)
+		protected.DELETE("/expenses/:id", authz.RequirePermission(domain.ModuleExpenses, domain.ActionDelete), h.Expense.Delete)
+		protected.POST("/expenses/:id/receipt", authz.RequirePermission(domain.ModuleExpenses, domain.ActionUpdate), h.Expense.UploadReceipt)
+		protected.POST("/expenses/:id/approve", authz.RequirePermission(domain.ModuleExpenses, domain.ActionUpdate), h.Expense.Approve)
+		protected.POST("/expenses/:id/reject", authz.RequirePermission(domain.ModuleExpenses, domain.ActionUpdate), h.Expense.Reject)
+		protected.GET("/cases/:id/expenses", authz.RequirePermission(domain.ModuleExpenses, domain.ActionRead), h.Expense.GetByCaseID)
+
+		protected.POST("/fee-agreements", authz.RequirePermission(domain.ModuleBilling, domain.ActionCreate), h.Fee.Create)
+		protected.GET("/fee-agreements/:id", authz.RequirePermission(domain.ModuleBilling, domain.ActionRead), h.Fee.GetByID)
+		protected.PUT("/fee-agreements/:id", authz.RequirePermission(domain.ModuleBilling, domain.ActionUpdate), h.Fee.Update)
+		protected.DELETE("/fee-agreements/:id", authz.RequirePermission(domain.ModuleBilling, domain.ActionDelete), h.Fee.Delete)
+		protected.GET("/clients/:id/fee-agreements", authz.RequirePermission(domain.ModuleBilling, domain.ActionRead), h.Fee.GetByClientID)
+
+		protected.POST("/invoices/generate", authz.RequirePermission(domain.ModuleBilling, domain.ActionCreate), h.Invoice.Generate)
+		protected.GET("/invoices", authz.RequirePermission(domain.ModuleBilling, domain.ActionRead), h.Invoice.List)
+		protected.GET("/invoices/:id", authz.RequirePermission(domain.ModuleBilling, domain.ActionRead), h.Invoice.GetByID)
+		protected.PUT("/invoices/:id", authz.RequirePermission(domain.ModuleBilling, domain.ActionUpdate), h.Invoice.Update)
+		protected.POST("/invoices/:id/issue", authz.RequirePermission(domain.ModuleBilling, domain.ActionUpdate), h.Invoice.Issue)
+		protected.POST("/invoices/:id/pay", authz.RequirePermission(domain.ModuleBilling, domain.ActionUpdate), h.Invoice.Pay)
+		protected.POST("/invoices/:id/cancel", authz.RequirePermission(domain.ModuleBilling, domain.ActionUpdate), h.Invoice.Cancel)
+		protected.DELETE("/invoices/:id", authz.RequirePermission(domain.ModuleBilling, domain.ActionDelete), h.Invoice.Delete)
+		protected.GET("/invoices/:id/pix", authz.RequirePermission(domain.ModuleBilling, domain.ActionRead), h.Pix.Charge)
+		protected.GET("/invoices/:id/pix/qrcode", authz.RequirePermission(domain.ModuleBilling, domain.ActionRead), h.Pix.QRCode)
+		protected.POST("/invoices/pix/reconciliation", authz.RequirePermission(domain.ModuleBilling, domain.ActionUpdate), h.Pix.Reconcile)
+		protected.GET("/clients/:id/statement", authz.RequirePermission(domain.ModuleBilling, domain.ActionRead), h.Invoice.Statement)
+		protected.GET("/billing/monthly", authz.RequirePermission(domain.ModuleBilling, domain.ActionRead), h.Invoice.MonthlySummary)
+
+		protected.POST("/cases/:id/documents", authz.RequirePermission(domain.ModuleDocuments, domain.ActionCreate), h.Document.Upload)
+		protected.GET("/cases/:id/documents", authz.RequirePermission(domain.ModuleDocuments, domain.ActionRead), h.Document.GetByCaseID)
+		protected.GET("/documents/:id", authz.RequirePermission(domain.ModuleDocuments, domain.ActionRead), h.Document.GetByID)
+		protected.GET("/documents/:id/download", authz.RequirePermission(domain.ModuleDocuments, domain.ActionRead), h.Document.Download)
+		protected.POST("/documents/:id/versions", authz.RequirePermission(domain.ModuleDocuments, domain.ActionUpdate), h.Document.AddVersion)
+		protected.GET("/documents/:id/versions", authz.RequirePermission(domain.ModuleDocuments, domain.ActionRead), h.Document.GetVersions)
+		protected.GET("/documents/:id/versions/:version/download", authz.RequirePermission(domain.ModuleDocuments, domain.ActionRead), h.Document.DownloadVersion)
+		protected.POST("/documents/:id/versions/:version/restore", authz.RequirePermission(domain.ModuleDocuments, domain.ActionUpdate), h.Document.RestoreVersion)
+		protected.PUT("/documents/:id", authz.RequirePermission(domain.ModuleDocuments, domain.ActionUpdate), h.Document.Update)
+		protected.DELETE("/documents/:id", authz.RequirePermission(domain.ModuleDocuments, domain.ActionDelete), h.Document.Delete)
+
+		// Lixeira: usuários, processos, clientes e documentos excluídos
+		protected.GET("/trash", authz.RequirePermission(domain.ModuleTrash, domain.ActionRead), h.Trash.List)
+		protected.POST("/trash/:kind/:id/restore", authz.RequirePermission(domain.ModuleTrash, domain.ActionUpdate), h.Trash.Restore)
+		protected.DELETE("/trash/:kind/:id", authz.RequirePermission(domain.ModuleTrash, domain.ActionDelete), h.Trash.Purge)
 	}
 }
@@ -0,0 +1,88 @@
+package security
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	// ErrInvalidToken é retornado quando o token não pode ser validado
+	ErrInvalidToken = errors.New("token inválido")
+
+	// ErrExpiredToken é retornado quando o token já expirou
+	ErrExpiredToken = errors.New("token expirado")
+)
+
+// Claims representa as informações transportadas no token de acesso
+type Claims struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Role   string `json:"role"`
+	// Generation é a geração de sessões do usuário na emissão do token
+	Generation int `json:"gen"`
+	jwt.RegisteredClaims
+}
+
+// TokenManager emite e valida tokens JWT assinados com HMAC-SHA256
+type TokenManager struct {
+	secret []byte
+	expiry time.Duration
+}
+
+// NewTokenManager cria um gerenciador de tokens com o segredo e a validade informados
+func NewTokenManager(secret string, expiry time.Duration) *TokenManager {
+	return &TokenManager{
+		secret: []byte(secret),
+		expiry: expiry,
+	}
+}
+
+// Generate emite um novo token de acesso para o usuário
+func (m *TokenManager) Generate(userID, email, role string, generation int) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(m.expiry)
+
+	claims := Claims{
+		UserID:     userID,
+		Email:      email,
+		Role:       role,
+		Generation: generation,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("falha ao assinar token: %v", err)
+	}
+
+	return signed, expiresAt, nil
+}
+
+// Parse valida a assinatura e a validade do token e retorna suas claims
+func (m *TokenManager) Parse(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return m.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+
+	if !token.Valid || claims.UserID == "" {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
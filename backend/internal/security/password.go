@@ -2,8 +2,9 @@ package security
 
 import (
 	"crypto/rand"
-	"encoding/base64"
 	"errors"
+	"math/big"
+	"sync"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -29,27 +30,44 @@ func CheckPassword(password, hash string) bool {
 	return err == nil
 }
 
-// GenerateRandomPassword gera uma senha aleatória segura
+// dummyPasswordHash tem o mesmo custo dos hashes reais e é gerado uma única vez
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("jurisconnect-dummy-password"), Cost)
+	return hash
+})
+
+// SimulatePasswordCheck faz uma comparação descartável quando o usuário não
+// existe, para que o tempo de resposta não revele quais emails estão cadastrados
+func SimulatePasswordCheck(password string) {
+	_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
+}
+
+// passwordAlphabet contém as classes de caracteres exigidas por ValidatePasswordStrength
+const passwordAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz23456789!@#$%^&*"
+
+// GenerateRandomPassword gera uma senha aleatória segura que atende aos
+// requisitos de ValidatePasswordStrength
 func GenerateRandomPassword(length int) (string, error) {
 	if length < 8 {
 		return "", errors.New("o comprimento mínimo da senha deve ser 8")
 	}
 
-	// Gerar bytes aleatórios
-	bytes := make([]byte, length)
-	if _, err := rand.Read(bytes); err != nil {
-		return "", err
-	}
-
-	// Converter para string base64
-	password := base64.URLEncoding.EncodeToString(bytes)
+	max := big.NewInt(int64(len(passwordAlphabet)))
+	password := make([]byte, length)
+	for {
+		for i := range password {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return "", err
+			}
+			password[i] = passwordAlphabet[n.Int64()]
+		}
 
-	// Garantir que a senha tenha o comprimento desejado
-	if len(password) > length {
-		password = password[:length]
+		// Sortear de novo se faltar alguma classe de caractere
+		if ValidatePasswordStrength(string(password)) == nil {
+			return string(password), nil
+		}
 	}
-
-	return password, nil
 }
 
 // ValidatePasswordStrength verifica se a senha atende aos requisitos de segurança
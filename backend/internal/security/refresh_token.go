@@ -0,0 +1,26 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// refreshTokenBytes define a quantidade de bytes aleatórios do refresh token
+const refreshTokenBytes = 32
+
+// GenerateOpaqueToken gera um token aleatório e opaco, adequado para refresh tokens
+func GenerateOpaqueToken() (string, error) {
+	bytes := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// HashToken calcula o SHA-256 do token para armazenamento seguro
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/security"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrInvalidRefreshToken é retornado quando o refresh token não existe, expirou ou foi revogado
+	ErrInvalidRefreshToken = errors.New("refresh token inválido")
+
+	// ErrRefreshTokenReused é retornado quando um refresh token já rotacionado é apresentado novamente
+	ErrRefreshTokenReused = errors.New("refresh token reutilizado; todas as sessões desta família foram encerradas")
+)
+
+// SessionInfo identifica o cliente que abriu a sessão
+type SessionInfo struct {
+	UserAgent string
+	IP        string
+}
+
+type AuthService struct {
+	userRepo      domain.UserRepository
+	refreshRepo   domain.RefreshTokenRepository
+	tokens        *security.TokenManager
+	refreshExpiry time.Duration
+}
+
+func NewAuthService(userRepo domain.UserRepository, refreshRepo domain.RefreshTokenRepository, tokens *security.TokenManager, refreshExpiry time.Duration) *AuthService {
+	return &AuthService{
+		userRepo:      userRepo,
+		refreshRepo:   refreshRepo,
+		tokens:        tokens,
+		refreshExpiry: refreshExpiry,
+	}
+}
+
+// IssueTokens inicia uma nova família de tokens para o usuário (login)
+func (s *AuthService) IssueTokens(user *domain.User, session SessionInfo) (*domain.TokenPair, error) {
+	return s.issue(user, primitive.NewObjectID().Hex(), primitive.NewObjectID(), session)
+}
+
+// Refresh rotaciona o refresh token, emitindo um novo par. A reutilização de
+// um token já rotacionado revoga toda a família.
+func (s *AuthService) Refresh(rawToken string, session SessionInfo) (*domain.TokenPair, error) {
+	current, err := s.refreshRepo.FindByHash(security.HashToken(rawToken))
+	if err != nil {
+		if errors.Is(err, repositories.ErrRefreshTokenNotFound) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	if current.RevokedAt != nil {
+		// Token já rotacionado sendo reapresentado: possível roubo
+		if !current.ReplacedBy.IsZero() {
+			if err := s.refreshRepo.RevokeFamily(current.FamilyID); err != nil {
+				return nil, err
+			}
+			return nil, ErrRefreshTokenReused
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if time.Now().After(current.ExpiresAt) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	user, err := s.userRepo.FindByID(current.UserID.Hex())
+	if err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+	if !user.IsActive {
+		return nil, ErrUserInactive
+	}
+	// Emitido antes de um "encerrar todas as sessões"
+	if current.Generation != user.TokenGeneration {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	nextID := primitive.NewObjectID()
+	if err := s.refreshRepo.MarkRotated(current.ID.Hex(), nextID); err != nil {
+		// Outra requisição rotacionou o mesmo token ao mesmo tempo
+		if errors.Is(err, repositories.ErrRefreshTokenAlreadyRevoked) {
+			if err := s.refreshRepo.RevokeFamily(current.FamilyID); err != nil {
+				return nil, err
+			}
+			return nil, ErrRefreshTokenReused
+		}
+		return nil, err
+	}
+
+	return s.issue(user, current.FamilyID, nextID, session)
+}
+
+// Logout revoga a família do refresh token informado, encerrando a sessão
+func (s *AuthService) Logout(rawToken string) error {
+	current, err := s.refreshRepo.FindByHash(security.HashToken(rawToken))
+	if err != nil {
+		if errors.Is(err, repositories.ErrRefreshTokenNotFound) {
+			return ErrInvalidRefreshToken
+		}
+		return err
+	}
+	return s.refreshRepo.RevokeFamily(current.FamilyID)
+}
+
+// LogoutAll revoga todos os refresh tokens do usuário e invalida os tokens de
+// acesso emitidos até o momento
+func (s *AuthService) LogoutAll(userID string) error {
+	if err := s.userRepo.RevokeTokens(userID); err != nil {
+		return err
+	}
+	return s.refreshRepo.RevokeAllByUser(userID)
+}
+
+func (s *AuthService) issue(user *domain.User, familyID string, refreshID primitive.ObjectID, session SessionInfo) (*domain.TokenPair, error) {
+	accessToken, expiresAt, err := s.tokens.Generate(user.ID.Hex(), user.PersonalInfo.Email, user.Role, user.TokenGeneration)
+	if err != nil {
+		return nil, err
+	}
+
+	rawRefresh, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	refresh := &domain.RefreshToken{
+		ID:         refreshID,
+		UserID:     user.ID,
+		FamilyID:   familyID,
+		Generation: user.TokenGeneration,
+		TokenHash:  security.HashToken(rawRefresh),
+		UserAgent:  session.UserAgent,
+		IP:         session.IP,
+		ExpiresAt:  now.Add(s.refreshExpiry),
+		CreatedAt:  now,
+	}
+	if err := s.refreshRepo.Create(refresh); err != nil {
+		return nil, err
+	}
+
+	return &domain.TokenPair{
+		AccessToken:      accessToken,
+		TokenType:        "Bearer",
+		ExpiresAt:        expiresAt,
+		RefreshToken:     rawRefresh,
+		RefreshExpiresAt: refresh.ExpiresAt,
+	}, nil
+}
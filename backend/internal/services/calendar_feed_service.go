@@ -0,0 +1,273 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/security"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrInvalidFeedToken é retornado quando o token da assinatura não existe ou foi revogado
+var ErrInvalidFeedToken = errors.New("token de assinatura de agenda inválido")
+
+const (
+	// feedHistoryDays define quantos dias passados aparecem no feed
+	feedHistoryDays = 90
+
+	// feedHorizonDays define até quantos dias à frente os compromissos são exportados
+	feedHorizonDays = 730
+)
+
+var eventTypeLabels = map[domain.EventType]string{
+	domain.EventTypeHearing:   "Audiência",
+	domain.EventTypeMeeting:   "Reunião",
+	domain.EventTypeDiligence: "Diligência",
+}
+
+type CalendarFeedService struct {
+	feedRepo     domain.CalendarFeedRepository
+	deadlineRepo domain.DeadlineRepository
+	eventRepo    domain.EventRepository
+	caseRepo     domain.CaseRepository
+	userRepo     domain.UserRepository
+}
+
+func NewCalendarFeedService(feedRepo domain.CalendarFeedRepository, deadlineRepo domain.DeadlineRepository, eventRepo domain.EventRepository, caseRepo domain.CaseRepository, userRepo domain.UserRepository) *CalendarFeedService {
+	return &CalendarFeedService{
+		feedRepo:     feedRepo,
+		deadlineRepo: deadlineRepo,
+		eventRepo:    eventRepo,
+		caseRepo:     caseRepo,
+		userRepo:     userRepo,
+	}
+}
+
+// Create gera uma nova assinatura e retorna o token da URL, que só é exibido
+// nesta resposta
+func (s *CalendarFeedService) Create(feed *domain.CalendarFeed) (string, error) {
+	feed.Name = strings.TrimSpace(feed.Name)
+	if len(feed.Name) > 100 {
+		return "", newValidationError("nome da assinatura deve ter no máximo 100 caracteres")
+	}
+
+	if !feed.CaseID.IsZero() {
+		case_, err := s.caseRepo.FindByID(feed.CaseID.Hex())
+		if err != nil {
+			if errors.Is(err, repositories.ErrCaseNotFound) {
+				return "", newValidationError("processo não encontrado")
+			}
+			return "", err
+		}
+		if feed.Name == "" {
+			feed.Name = "Processo " + caseLabel(case_)
+		}
+	}
+	if feed.Name == "" {
+		feed.Name = "Minha agenda"
+	}
+
+	token, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	feed.TokenHash = security.HashToken(token)
+	feed.RevokedAt = nil
+	feed.LastAccessedAt = nil
+	feed.CreatedAt = time.Now()
+
+	if err := s.feedRepo.Create(feed); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (s *CalendarFeedService) ListByUser(userID string) ([]*domain.CalendarFeed, error) {
+	return s.feedRepo.FindByUserID(userID)
+}
+
+// Revoke invalida a URL da assinatura; apenas o dono pode revogá-la
+func (s *CalendarFeedService) Revoke(userID, feedID string) error {
+	feed, err := s.feedRepo.FindByID(feedID)
+	if err != nil {
+		return err
+	}
+	if feed.UserID.Hex() != userID {
+		return repositories.ErrCalendarFeedNotFound
+	}
+	return s.feedRepo.Revoke(feedID)
+}
+
+// RevokeAll invalida todas as assinaturas do usuário
+func (s *CalendarFeedService) RevokeAll(userID string) error {
+	return s.feedRepo.RevokeAllByUser(userID)
+}
+
+// Render gera o conteúdo iCalendar da assinatura identificada pelo token
+func (s *CalendarFeedService) Render(token string) ([]byte, error) {
+	feed, err := s.feedRepo.FindByTokenHash(security.HashToken(token))
+	if err != nil {
+		if errors.Is(err, repositories.ErrCalendarFeedNotFound) {
+			return nil, ErrInvalidFeedToken
+		}
+		return nil, err
+	}
+	if feed.RevokedAt != nil {
+		return nil, ErrInvalidFeedToken
+	}
+
+	// A assinatura deixa de valer quando o dono é removido
+	if _, err := s.userRepo.FindByID(feed.UserID.Hex()); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return nil, ErrInvalidFeedToken
+		}
+		return nil, err
+	}
+
+	deadlines, events, err := s.collect(feed)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.feedEvents(deadlines, events)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := calendar.WriteFeed(&buf, feed.Name, items); err != nil {
+		return nil, err
+	}
+
+	// Falha ao registrar o acesso não impede a entrega do feed
+	_ = s.feedRepo.Touch(feed.ID, time.Now())
+
+	return buf.Bytes(), nil
+}
+
+func (s *CalendarFeedService) collect(feed *domain.CalendarFeed) ([]*domain.Deadline, []*domain.Event, error) {
+	now := time.Now()
+	from := now.AddDate(0, 0, -feedHistoryDays)
+	to := now.AddDate(0, 0, feedHorizonDays)
+
+	if !feed.CaseID.IsZero() {
+		deadlines, err := s.deadlineRepo.FindByCaseID(feed.CaseID.Hex(), "")
+		if err != nil {
+			return nil, nil, err
+		}
+		events, err := s.eventRepo.Find(domain.EventQuery{From: from, To: to, CaseID: feed.CaseID.Hex()})
+		if err != nil {
+			return nil, nil, err
+		}
+		return deadlines, events, nil
+	}
+
+	deadlines, err := s.deadlineRepo.FindByAssignee(feed.UserID.Hex(), calendar.Date(from))
+	if err != nil {
+		return nil, nil, err
+	}
+	events, err := s.eventRepo.Find(domain.EventQuery{From: from, To: to, UserID: feed.UserID.Hex()})
+	if err != nil {
+		return nil, nil, err
+	}
+	return deadlines, events, nil
+}
+
+func (s *CalendarFeedService) feedEvents(deadlines []*domain.Deadline, events []*domain.Event) ([]calendar.FeedEvent, error) {
+	cases := make(map[primitive.ObjectID]*domain.Case)
+	lookupCase := func(id primitive.ObjectID) (*domain.Case, error) {
+		if id.IsZero() {
+			return nil, nil
+		}
+		if case_, ok := cases[id]; ok {
+			return case_, nil
+		}
+		case_, err := s.caseRepo.FindByID(id.Hex())
+		if err != nil && !errors.Is(err, repositories.ErrCaseNotFound) {
+			return nil, err
+		}
+		cases[id] = case_
+		return case_, nil
+	}
+
+	items := make([]calendar.FeedEvent, 0, len(deadlines)+len(events))
+	for _, deadline := range deadlines {
+		case_, err := lookupCase(deadline.CaseID)
+		if err != nil {
+			return nil, err
+		}
+
+		summary := "Prazo: " + deadline.Title
+		status := calendar.FeedStatusConfirmed
+		switch deadline.Status {
+		case domain.DeadlineStatusDone:
+			summary = "[Cumprido] " + summary
+		case domain.DeadlineStatusCancelled:
+			status = calendar.FeedStatusCancelled
+		}
+
+		items = append(items, calendar.FeedEvent{
+			UID:          "deadline-" + deadline.ID.Hex() + "@jurisconnect",
+			Summary:      summary,
+			Description:  feedDescription(deadline.Description, case_),
+			Start:        deadline.DueDate,
+			AllDay:       true,
+			Status:       status,
+			LastModified: deadline.UpdatedAt,
+		})
+	}
+
+	for _, event := range events {
+		case_, err := lookupCase(event.CaseID)
+		if err != nil {
+			return nil, err
+		}
+
+		status := calendar.FeedStatusConfirmed
+		if event.Status == domain.EventStatusCancelled {
+			status = calendar.FeedStatusCancelled
+		}
+
+		items = append(items, calendar.FeedEvent{
+			UID:          "event-" + event.ID.Hex() + "@jurisconnect",
+			Summary:      eventTypeLabels[event.Type] + ": " + event.Title,
+			Description:  feedDescription(event.Description, case_),
+			Location:     event.Location,
+			Start:        event.StartAt,
+			End:          event.EndAt,
+			Status:       status,
+			LastModified: event.UpdatedAt,
+		})
+	}
+
+	return items, nil
+}
+
+func feedDescription(description string, case_ *domain.Case) string {
+	if case_ == nil {
+		return description
+	}
+	line := "Processo " + caseLabel(case_)
+	if case_.Number != "" {
+		line = fmt.Sprintf("Processo %s - %s", case_.Number, case_.Title)
+	}
+	if description == "" {
+		return line
+	}
+	return description + "\n\n" + line
+}
+
+// caseLabel identifica o processo pelo número CNJ ou, sem número, pelo título
+func caseLabel(case_ *domain.Case) string {
+	if case_.Number != "" {
+		return case_.Number
+	}
+	return case_.Title
+}
@@ -0,0 +1,390 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/validation"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrInvalidStatusTransition é retornado quando a mudança de status não é permitida
+var ErrInvalidStatusTransition = errors.New("transição de status não permitida")
+
+type CaseService struct {
+	caseRepo    domain.CaseRepository
+	historyRepo domain.CaseStatusHistoryRepository
+	userRepo    domain.UserRepository
+	clientRepo  domain.ClientRepository
+	recordRefs  domain.RecordReferenceRepository
+	conflicts   *ConflictService
+}
+
+func NewCaseService(caseRepo domain.CaseRepository, historyRepo domain.CaseStatusHistoryRepository, userRepo domain.UserRepository, clientRepo domain.ClientRepository, recordRefs domain.RecordReferenceRepository, conflicts *ConflictService) *CaseService {
+	return &CaseService{caseRepo: caseRepo, historyRepo: historyRepo, userRepo: userRepo, clientRepo: clientRepo, recordRefs: recordRefs, conflicts: conflicts}
+}
+
+func (s *CaseService) validate(case_ *domain.Case) error {
+	case_.Title = strings.TrimSpace(case_.Title)
+	if case_.Title == "" {
+		return newValidationError("título do processo é obrigatório")
+	}
+	if len(case_.Title) > 200 {
+		return newValidationError("título do processo deve ter no máximo 200 caracteres")
+	}
+	// Número CNJ é opcional (processos ainda não distribuídos), mas se
+	// informado precisa ser válido e é armazenado no formato canônico
+	case_.Number = strings.TrimSpace(case_.Number)
+	if case_.Number != "" {
+		info, err := validation.ParseCNJNumber(case_.Number)
+		if err != nil {
+			return newValidationError("%v", err)
+		}
+		case_.Number = validation.NormalizeCNJNumber(case_.Number)
+		case_.NumberInfo = info
+	} else {
+		case_.NumberInfo = nil
+	}
+
+	if case_.ClientID.IsZero() {
+		return newValidationError("cliente do processo é obrigatório")
+	}
+	if case_.LawyerID.IsZero() {
+		return newValidationError("advogado responsável é obrigatório")
+	}
+
+	if err := s.validateClient(case_.ClientID); err != nil {
+		return err
+	}
+
+	// O advogado responsável precisa ser um usuário cadastrado
+	if _, err := s.userRepo.FindByID(case_.LawyerID.Hex()); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return newValidationError("advogado responsável não encontrado")
+		}
+		return err
+	}
+
+	if err := s.validateParties(case_.Parties); err != nil {
+		return err
+	}
+
+	return s.validateTeam(case_)
+}
+
+func (s *CaseService) validateParties(parties []domain.CaseParty) error {
+	for i := range parties {
+		party := &parties[i]
+		party.Name = strings.TrimSpace(party.Name)
+		if party.Name == "" {
+			return newValidationError("nome da parte é obrigatório")
+		}
+		party.SearchName = validation.NormalizeName(party.Name)
+		if !party.Side.IsValid() {
+			return newValidationError("posição processual inválida para a parte %s: %s", party.Name, party.Side)
+		}
+
+		party.Document = validation.OnlyDigits(party.Document)
+		if party.Document != "" && !validation.IsValidCPFOrCNPJ(party.Document) {
+			return newValidationError("documento da parte %s deve ser um CPF ou CNPJ válido", party.Name)
+		}
+		if !party.ClientID.IsZero() {
+			if err := s.validateClient(party.ClientID); err != nil {
+				return err
+			}
+		}
+
+		for j := range party.Counsel {
+			counsel := &party.Counsel[j]
+			counsel.Name = strings.TrimSpace(counsel.Name)
+			counsel.OABNumber = strings.TrimSpace(counsel.OABNumber)
+			counsel.OABState = strings.ToUpper(strings.TrimSpace(counsel.OABState))
+			if counsel.Name == "" || counsel.OABNumber == "" || len(counsel.OABState) != 2 {
+				return newValidationError("advogados da parte %s precisam de nome, número e UF da OAB", party.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateClient verifica se o cliente está cadastrado
+func (s *CaseService) validateClient(clientID primitive.ObjectID) error {
+	if _, err := s.clientRepo.FindByID(clientID.Hex()); err != nil {
+		if errors.Is(err, repositories.ErrClientNotFound) {
+			return newValidationError("cliente não encontrado: %s", clientID.Hex())
+		}
+		return err
+	}
+	return nil
+}
+
+// validateTeam confere os integrantes da equipe e garante que o advogado
+// responsável (LawyerID) faça parte dela como "responsavel"
+func (s *CaseService) validateTeam(case_ *domain.Case) error {
+	seen := make(map[primitive.ObjectID]bool)
+	hasLawyer := false
+	for i := range case_.Team {
+		member := &case_.Team[i]
+		if member.UserID.IsZero() {
+			return newValidationError("integrante da equipe sem usuário")
+		}
+		if seen[member.UserID] {
+			return newValidationError("usuário %s aparece mais de uma vez na equipe", member.UserID.Hex())
+		}
+		seen[member.UserID] = true
+
+		if !member.Role.IsValid() {
+			return newValidationError("papel inválido na equipe: %s", member.Role)
+		}
+
+		if member.UserID == case_.LawyerID {
+			member.Role = domain.CaseTeamRoleResponsible
+			hasLawyer = true
+			continue
+		}
+
+		if _, err := s.userRepo.FindByID(member.UserID.Hex()); err != nil {
+			if errors.Is(err, repositories.ErrUserNotFound) {
+				return newValidationError("integrante da equipe não encontrado: %s", member.UserID.Hex())
+			}
+			return err
+		}
+	}
+
+	if !hasLawyer {
+		case_.Team = append(case_.Team, domain.CaseTeamMember{
+			UserID: case_.LawyerID,
+			Role:   domain.CaseTeamRoleResponsible,
+		})
+	}
+
+	return nil
+}
+
+// stampTeam define a data de designação dos novos integrantes, preservando a
+// dos que já estavam na equipe
+func stampTeam(team []domain.CaseTeamMember, previous []domain.CaseTeamMember, now time.Time) {
+	assigned := make(map[primitive.ObjectID]time.Time)
+	for _, member := range previous {
+		assigned[member.UserID] = member.AssignedAt
+	}
+	for i := range team {
+		if at, ok := assigned[team[i].UserID]; ok && !at.IsZero() {
+			team[i].AssignedAt = at
+		} else {
+			team[i].AssignedAt = now
+		}
+	}
+}
+
+// Create cadastra o processo após a verificação de conflito de interesses;
+// havendo conflito, exige case_.ConflictOverride com ciência e motivo
+func (s *CaseService) Create(case_ *domain.Case) error {
+	if err := s.validate(case_); err != nil {
+		return err
+	}
+
+	report, err := s.conflicts.CheckCase(case_)
+	if err != nil {
+		return err
+	}
+	check, err := s.conflicts.Enforce(domain.ConflictTargetCase, report, case_.ConflictOverride, case_.CreatedBy)
+	if err != nil {
+		return err
+	}
+	if check == nil {
+		case_.ConflictOverride = nil
+	}
+
+	// Todo processo inicia o ciclo de vida como "novo"
+	case_.Status = domain.CaseStatusNew
+
+	now := time.Now()
+	stampTeam(case_.Team, nil, now)
+	case_.CreatedAt = now
+	case_.UpdatedAt = now
+
+	if err := s.caseRepo.Create(case_); err != nil {
+		return err
+	}
+
+	if check != nil {
+		if err := s.conflicts.Record(check, case_.ID); err != nil {
+			return err
+		}
+	}
+
+	return s.historyRepo.Create(&domain.CaseStatusChange{
+		CaseID:    case_.ID,
+		ToStatus:  case_.Status,
+		Reason:    "cadastro do processo",
+		ChangedBy: case_.CreatedBy,
+		ChangedAt: now,
+	})
+}
+
+func (s *CaseService) GetByID(id string) (*domain.Case, error) {
+	return s.caseRepo.FindByID(id)
+}
+
+func (s *CaseService) GetByNumber(number string) (*domain.Case, error) {
+	return s.caseRepo.FindByNumber(validation.NormalizeCNJNumber(number))
+}
+
+func (s *CaseService) GetByClientID(clientID string) ([]*domain.Case, error) {
+	return s.caseRepo.FindByClientID(clientID)
+}
+
+func (s *CaseService) GetByLawyerID(lawyerID string) ([]*domain.Case, error) {
+	return s.caseRepo.FindByLawyerID(lawyerID)
+}
+
+// Update altera o processo. Trocar o cliente ou as partes passa pela mesma
+// verificação de conflito do cadastro, exigindo override se houver conflito.
+func (s *CaseService) Update(case_ *domain.Case, override *domain.ConflictOverride, updatedBy primitive.ObjectID) error {
+	existing, err := s.caseRepo.FindByID(case_.ID.Hex())
+	if err != nil {
+		return err
+	}
+
+	if err := s.validate(case_); err != nil {
+		return err
+	}
+
+	// O status só muda pelo fluxo de transição, que registra o histórico
+	if case_.Status != existing.Status {
+		return newValidationError("o status deve ser alterado pelo endpoint de transição de status")
+	}
+
+	case_.ConflictOverride = existing.ConflictOverride
+	var check *domain.ConflictCheck
+	if case_.ClientID != existing.ClientID || partiesChanged(existing.Parties, case_.Parties) {
+		report, err := s.conflicts.CheckCase(case_)
+		if err != nil {
+			return err
+		}
+		check, err = s.conflicts.Enforce(domain.ConflictTargetCase, report, override, updatedBy)
+		if err != nil {
+			return err
+		}
+		if check != nil {
+			case_.ConflictOverride = override
+		}
+	}
+
+	// Preservar os dados de criação originais
+	case_.CreatedAt = existing.CreatedAt
+	case_.CreatedBy = existing.CreatedBy
+	case_.UpdatedAt = time.Now()
+	stampTeam(case_.Team, existing.Team, case_.UpdatedAt)
+
+	if err := s.caseRepo.Update(case_); err != nil {
+		return err
+	}
+
+	if check != nil {
+		if err := s.conflicts.Record(check, case_.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// partiesChanged informa se alguma parte foi incluída, removida ou teve nome,
+// documento, polo ou cliente alterados
+func partiesChanged(previous, current []domain.CaseParty) bool {
+	if len(previous) != len(current) {
+		return true
+	}
+	for i := range current {
+		a, b := previous[i], current[i]
+		if a.SearchName != b.SearchName || a.Document != b.Document || a.Side != b.Side || a.ClientID != b.ClientID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *CaseService) GetByParty(query domain.PartyQuery) ([]*domain.Case, error) {
+	query.Document = validation.OnlyDigits(query.Document)
+	query.Name = strings.TrimSpace(query.Name)
+	if query.Document == "" && query.Name == "" && query.ClientID == "" {
+		return nil, newValidationError("informe o documento, o nome ou o cliente da parte")
+	}
+	if query.Side != "" && !query.Side.IsValid() {
+		return nil, newValidationError("posição processual inválida: %s", query.Side)
+	}
+	return s.caseRepo.FindByParty(query)
+}
+
+func (s *CaseService) GetByTeamMember(userID string) ([]*domain.Case, error) {
+	return s.caseRepo.FindByTeamMember(userID)
+}
+
+// TransitionStatus muda o status do processo conforme o ciclo de vida e
+// registra a mudança no histórico
+func (s *CaseService) TransitionStatus(id string, to domain.CaseStatus, reason string, changedBy primitive.ObjectID) (*domain.Case, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, newValidationError("o motivo da mudança de status é obrigatório")
+	}
+	if !to.IsValid() {
+		return nil, newValidationError("status desconhecido: %s", to)
+	}
+
+	case_, err := s.caseRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Processos com status anterior ao ciclo de vida podem migrar para qualquer status válido
+	from := case_.Status
+	if from.IsValid() && !from.CanTransitionTo(to) {
+		return nil, fmt.Errorf("%w: de %s para %s", ErrInvalidStatusTransition, from, to)
+	}
+
+	if err := s.caseRepo.UpdateStatus(id, from, to); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.historyRepo.Create(&domain.CaseStatusChange{
+		CaseID:     case_.ID,
+		FromStatus: from,
+		ToStatus:   to,
+		Reason:     reason,
+		ChangedBy:  changedBy,
+		ChangedAt:  now,
+	}); err != nil {
+		return nil, err
+	}
+
+	case_.Status = to
+	case_.UpdatedAt = now
+	return case_, nil
+}
+
+// GetStatusHistory lista as mudanças de status do processo, opcionalmente
+// apenas as que levaram ao status informado
+func (s *CaseService) GetStatusHistory(id string, status domain.CaseStatus) ([]*domain.CaseStatusChange, error) {
+	if _, err := s.caseRepo.FindByID(id); err != nil {
+		return nil, err
+	}
+	return s.historyRepo.FindByCaseID(id, status)
+}
+
+// Delete move o processo para a lixeira, de onde pode ser restaurado.
+// Processos com horas, despesas, contratos ou faturas não podem ser excluídos.
+func (s *CaseService) Delete(id string, deletedBy primitive.ObjectID) error {
+	if _, err := s.caseRepo.FindByID(id); err != nil {
+		return err
+	}
+	if err := checkRecordReferences(s.recordRefs.CountCaseReferences, id); err != nil {
+		return err
+	}
+	return s.caseRepo.Delete(id, deletedBy)
+}
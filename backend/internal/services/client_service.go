@@ -0,0 +1,234 @@
+package services
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/validation"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ClientService struct {
+	clientRepo domain.ClientRepository
+	recordRefs domain.RecordReferenceRepository
+	conflicts  *ConflictService
+}
+
+func NewClientService(clientRepo domain.ClientRepository, recordRefs domain.RecordReferenceRepository, conflicts *ConflictService) *ClientService {
+	return &ClientService{clientRepo: clientRepo, recordRefs: recordRefs, conflicts: conflicts}
+}
+
+func (s *ClientService) validate(client *domain.Client) error {
+	if !client.Type.IsValid() {
+		return newValidationError("tipo de cliente inválido: use \"pf\" ou \"pj\"")
+	}
+
+	client.Name = strings.TrimSpace(client.Name)
+	if client.Name == "" {
+		return newValidationError("nome do cliente é obrigatório")
+	}
+	if len(client.Name) > 200 {
+		return newValidationError("nome do cliente deve ter no máximo 200 caracteres")
+	}
+	client.SearchName = validation.NormalizeName(client.Name)
+	client.TradeName = strings.TrimSpace(client.TradeName)
+
+	client.Document = validation.OnlyDigits(client.Document)
+	switch client.Type {
+	case domain.ClientTypeIndividual:
+		if !validation.IsValidCPF(client.Document) {
+			return newValidationError("CPF inválido")
+		}
+		// Campos exclusivos de pessoa jurídica
+		client.TradeName = ""
+		client.StateRegistration = ""
+	case domain.ClientTypeCompany:
+		if !validation.IsValidCNPJ(client.Document) {
+			return newValidationError("CNPJ inválido")
+		}
+		// Campos exclusivos de pessoa física
+		client.RG = ""
+		client.BirthDate = time.Time{}
+	}
+
+	client.Email = strings.TrimSpace(client.Email)
+	if err := validateEmail(client.Email); err != nil {
+		return err
+	}
+	if err := validateOptionalPhone(client.Phone); err != nil {
+		return err
+	}
+	if client.HourlyRateCents < 0 {
+		return newValidationError("valor da hora não pode ser negativo")
+	}
+
+	for i := range client.Contacts {
+		contact := &client.Contacts[i]
+		contact.Name = strings.TrimSpace(contact.Name)
+		contact.Email = strings.TrimSpace(contact.Email)
+		if contact.Name == "" {
+			return newValidationError("nome do contato é obrigatório")
+		}
+		if err := validateEmail(contact.Email); err != nil {
+			return err
+		}
+		if err := validateOptionalPhone(contact.Phone); err != nil {
+			return err
+		}
+	}
+
+	for i := range client.Addresses {
+		address := &client.Addresses[i]
+		address.State = strings.ToUpper(strings.TrimSpace(address.State))
+		address.ZipCode = validation.OnlyDigits(address.ZipCode)
+		if address.State != "" && len(address.State) != 2 {
+			return newValidationError("UF do endereço deve ter 2 letras")
+		}
+		if address.ZipCode != "" && len(address.ZipCode) != 8 {
+			return newValidationError("CEP deve conter 8 dígitos")
+		}
+	}
+
+	if client.Contacts == nil {
+		client.Contacts = []domain.ClientContact{}
+	}
+	if client.Addresses == nil {
+		client.Addresses = []domain.Address{}
+	}
+
+	return nil
+}
+
+// validateEmail aceita email vazio; se informado, precisa ser um endereço válido
+func validateEmail(email string) error {
+	if email == "" {
+		return nil
+	}
+	if _, err := mail.ParseAddress(email); err != nil {
+		return newValidationError("email inválido: %s", email)
+	}
+	return nil
+}
+
+// validateOptionalPhone aceita telefone vazio ou com 10 a 11 dígitos (com DDD)
+func validateOptionalPhone(phone string) error {
+	digits := validation.OnlyDigits(phone)
+	if digits != "" && (len(digits) < 10 || len(digits) > 11) {
+		return newValidationError("telefone deve conter entre 10 e 11 dígitos")
+	}
+	return nil
+}
+
+// Create cadastra o cliente após a verificação de conflito de interesses;
+// havendo conflito, exige client.ConflictOverride com ciência e motivo
+func (s *ClientService) Create(client *domain.Client) error {
+	if err := s.validate(client); err != nil {
+		return err
+	}
+
+	report, err := s.conflicts.CheckClient(client)
+	if err != nil {
+		return err
+	}
+	check, err := s.conflicts.Enforce(domain.ConflictTargetClient, report, client.ConflictOverride, client.CreatedBy)
+	if err != nil {
+		return err
+	}
+	if check == nil {
+		client.ConflictOverride = nil
+	}
+
+	now := time.Now()
+	client.IsActive = true
+	client.CreatedAt = now
+	client.UpdatedAt = now
+
+	if err := s.clientRepo.Create(client); err != nil {
+		return err
+	}
+
+	if check != nil {
+		if err := s.conflicts.Record(check, client.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ClientService) GetByID(id string) (*domain.Client, error) {
+	return s.clientRepo.FindByID(id)
+}
+
+func (s *ClientService) GetByDocument(document string) (*domain.Client, error) {
+	return s.clientRepo.FindByDocument(validation.OnlyDigits(document))
+}
+
+// Search busca por trecho do nome (sem diferenciar acentos e maiúsculas) e
+// pelo início do CPF/CNPJ
+func (s *ClientService) Search(query domain.ClientQuery) ([]*domain.Client, error) {
+	query.Name = validation.NormalizeName(query.Name)
+	query.Document = validation.OnlyDigits(query.Document)
+	if query.Type != "" && !query.Type.IsValid() {
+		return nil, newValidationError("tipo de cliente inválido: %s", query.Type)
+	}
+	return s.clientRepo.Search(query)
+}
+
+// Update altera o cliente. Trocar o nome, o nome fantasia ou o documento
+// passa pela mesma verificação de conflito do cadastro.
+func (s *ClientService) Update(client *domain.Client, override *domain.ConflictOverride, updatedBy primitive.ObjectID) error {
+	existing, err := s.clientRepo.FindByID(client.ID.Hex())
+	if err != nil {
+		return err
+	}
+
+	if err := s.validate(client); err != nil {
+		return err
+	}
+
+	client.ConflictOverride = existing.ConflictOverride
+	var check *domain.ConflictCheck
+	if client.SearchName != existing.SearchName || client.TradeName != existing.TradeName || client.Document != existing.Document {
+		report, err := s.conflicts.CheckClient(client)
+		if err != nil {
+			return err
+		}
+		check, err = s.conflicts.Enforce(domain.ConflictTargetClient, report, override, updatedBy)
+		if err != nil {
+			return err
+		}
+		if check != nil {
+			client.ConflictOverride = override
+		}
+	}
+
+	// Preservar os dados de criação originais
+	client.CreatedAt = existing.CreatedAt
+	client.CreatedBy = existing.CreatedBy
+	client.UpdatedAt = time.Now()
+
+	if err := s.clientRepo.Update(client); err != nil {
+		return err
+	}
+
+	if check != nil {
+		if err := s.conflicts.Record(check, client.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete move o cliente para a lixeira apenas se não houver processos
+// (inclusive na lixeira) nem registros de faturamento vinculados a ele
+func (s *ClientService) Delete(id string, deletedBy primitive.ObjectID) error {
+	if _, err := s.clientRepo.FindByID(id); err != nil {
+		return err
+	}
+	if err := checkRecordReferences(s.recordRefs.CountClientReferences, id); err != nil {
+		return err
+	}
+	return s.clientRepo.Delete(id, deletedBy)
+}
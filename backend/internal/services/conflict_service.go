@@ -0,0 +1,396 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/validation"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrConflictOfInterest é retornado quando o cadastro encontra conflito de
+// interesses sem que o usuário tenha declarado ciência (override)
+var ErrConflictOfInterest = errors.New("possível conflito de interesses encontrado; confirme ciência e informe o motivo para prosseguir")
+
+// ConflictError carrega o relatório que impediu o cadastro
+type ConflictError struct {
+	Report *domain.ConflictReport
+}
+
+func (e *ConflictError) Error() string {
+	return ErrConflictOfInterest.Error()
+}
+
+func (e *ConflictError) Unwrap() error {
+	return ErrConflictOfInterest
+}
+
+// similarNameThreshold é a similaridade mínima para considerar dois nomes parecidos
+const similarNameThreshold = 0.85
+
+// nameStopwords são partículas e sufixos societários ignorados na comparação de nomes
+var nameStopwords = map[string]bool{
+	"da": true, "de": true, "do": true, "das": true, "dos": true, "e": true,
+	"ltda": true, "sa": true, "s/a": true, "me": true, "epp": true, "eireli": true,
+}
+
+type ConflictService struct {
+	clientRepo domain.ClientRepository
+	caseRepo   domain.CaseRepository
+	checkRepo  domain.ConflictCheckRepository
+}
+
+func NewConflictService(clientRepo domain.ClientRepository, caseRepo domain.CaseRepository, checkRepo domain.ConflictCheckRepository) *ConflictService {
+	return &ConflictService{clientRepo: clientRepo, caseRepo: caseRepo, checkRepo: checkRepo}
+}
+
+// Check procura as pessoas informadas entre os clientes e as partes dos
+// processos existentes, por documento e por nome (exato ou semelhante)
+func (s *ConflictService) Check(subjects []domain.ConflictSubject) (*domain.ConflictReport, error) {
+	if len(subjects) == 0 {
+		return nil, newValidationError("informe ao menos uma pessoa para a verificação de conflito")
+	}
+
+	for i := range subjects {
+		subject := &subjects[i]
+		subject.Name = strings.TrimSpace(subject.Name)
+		subject.Document = validation.OnlyDigits(subject.Document)
+		if subject.Name == "" && subject.Document == "" {
+			return nil, newValidationError("informe o nome ou o documento de cada pessoa")
+		}
+		if subject.Document != "" && !validation.IsValidCPFOrCNPJ(subject.Document) {
+			return nil, newValidationError("documento inválido para %s", subject.Name)
+		}
+		if subject.Role == "" {
+			subject.Role = domain.ConflictRoleClient
+		}
+		if !subject.Role.IsValid() {
+			return nil, newValidationError("papel inválido na verificação de conflito: %s", subject.Role)
+		}
+	}
+
+	report := &domain.ConflictReport{
+		Subjects:  subjects,
+		Matches:   []domain.ConflictMatch{},
+		CheckedAt: time.Now(),
+	}
+	for _, subject := range subjects {
+		if err := s.checkSubject(subject, report); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// CheckClient verifica um futuro cliente (e seu nome fantasia, se houver)
+func (s *ConflictService) CheckClient(client *domain.Client) (*domain.ConflictReport, error) {
+	subjects := []domain.ConflictSubject{
+		{Name: client.Name, Document: client.Document, Role: domain.ConflictRoleClient},
+	}
+	if client.TradeName != "" {
+		subjects = append(subjects, domain.ConflictSubject{Name: client.TradeName, Role: domain.ConflictRoleClient})
+	}
+
+	report, err := s.Check(subjects)
+	if err != nil || client.ID.IsZero() {
+		return report, err
+	}
+	excludeTarget(report, func(match domain.ConflictMatch) bool {
+		return match.Source == domain.ConflictSourceClient && match.ClientID == client.ID
+	})
+	return report, nil
+}
+
+// CheckCase verifica o cliente e as partes de um processo. Partes do
+// mesmo polo do cliente são tratadas como representadas; as demais, como
+// parte contrária.
+func (s *ConflictService) CheckCase(case_ *domain.Case) (*domain.ConflictReport, error) {
+	client, err := s.clientRepo.FindByID(case_.ClientID.Hex())
+	if err != nil {
+		if errors.Is(err, repositories.ErrClientNotFound) {
+			return nil, newValidationError("cliente não encontrado: %s", case_.ClientID.Hex())
+		}
+		return nil, err
+	}
+
+	isClient := func(party domain.CaseParty) bool {
+		return party.ClientID == case_.ClientID || (party.Document != "" && party.Document == client.Document)
+	}
+
+	var clientSide domain.PartySide
+	for _, party := range case_.Parties {
+		if isClient(party) {
+			clientSide = party.Side
+			break
+		}
+	}
+
+	subjects := []domain.ConflictSubject{
+		{Name: client.Name, Document: client.Document, Role: domain.ConflictRoleClient},
+	}
+	for _, party := range case_.Parties {
+		if isClient(party) {
+			continue
+		}
+		role := domain.ConflictRoleOpposing
+		if clientSide != "" && party.Side == clientSide {
+			role = domain.ConflictRoleClient
+		}
+		subjects = append(subjects, domain.ConflictSubject{Name: party.Name, Document: party.Document, Role: role})
+	}
+
+	report, err := s.Check(subjects)
+	if err != nil || case_.ID.IsZero() {
+		return report, err
+	}
+	excludeTarget(report, func(match domain.ConflictMatch) bool {
+		return match.CaseID == case_.ID
+	})
+	return report, nil
+}
+
+// excludeTarget descarta as coincidências com o próprio cadastro em edição
+func excludeTarget(report *domain.ConflictReport, isTarget func(domain.ConflictMatch) bool) {
+	matches := []domain.ConflictMatch{}
+	report.HasConflicts = false
+	for _, match := range report.Matches {
+		if isTarget(match) {
+			continue
+		}
+		if match.Conflict {
+			report.HasConflicts = true
+		}
+		matches = append(matches, match)
+	}
+	report.Matches = matches
+}
+
+// Enforce exige override com ciência expressa e motivo quando o relatório
+// aponta conflito e prepara o registro da decisão, gravado por Record depois
+// que o cadastro for salvo. Retorna nil quando não há conflito.
+func (s *ConflictService) Enforce(targetType string, report *domain.ConflictReport, override *domain.ConflictOverride, userID primitive.ObjectID) (*domain.ConflictCheck, error) {
+	if !report.HasConflicts {
+		return nil, nil
+	}
+	if override == nil || !override.Acknowledged || strings.TrimSpace(override.Reason) == "" {
+		return nil, &ConflictError{Report: report}
+	}
+
+	now := time.Now()
+	check := &domain.ConflictCheck{
+		ID:         primitive.NewObjectID(),
+		TargetType: targetType,
+		Subjects:   report.Subjects,
+		Matches:    report.Matches,
+		Reason:     strings.TrimSpace(override.Reason),
+		CheckedBy:  userID,
+		CreatedAt:  now,
+	}
+
+	override.Reason = check.Reason
+	override.CheckID = check.ID
+	override.AcknowledgedBy = userID
+	override.AcknowledgedAt = now
+	return check, nil
+}
+
+// Record grava o override preparado por Enforce, vinculado ao cadastro salvo
+func (s *ConflictService) Record(check *domain.ConflictCheck, targetID primitive.ObjectID) error {
+	check.TargetID = targetID
+	return s.checkRepo.Create(check)
+}
+
+// ListOverrides lista os conflitos aceitos, opcionalmente de um cadastro específico
+func (s *ConflictService) ListOverrides(targetType, targetID string) ([]*domain.ConflictCheck, error) {
+	if targetID == "" {
+		return s.checkRepo.FindAll()
+	}
+	if targetType != domain.ConflictTargetClient && targetType != domain.ConflictTargetCase {
+		return nil, newValidationError("tipo de cadastro inválido: use \"client\" ou \"case\"")
+	}
+	return s.checkRepo.FindByTarget(targetType, targetID)
+}
+
+func (s *ConflictService) checkSubject(subject domain.ConflictSubject, report *domain.ConflictReport) error {
+	name := validation.NormalizeName(subject.Name)
+	tokens := significantTokens(name)
+
+	clients, err := s.clientRepo.FindConflictCandidates(subject.Document, tokens)
+	if err != nil {
+		return err
+	}
+	for _, client := range clients {
+		matchType, score := matchPerson(subject.Document, name, client.Document, client.SearchName)
+		if matchType == "" {
+			continue
+		}
+		addMatch(report, domain.ConflictMatch{
+			Subject:   subject,
+			Source:    domain.ConflictSourceClient,
+			MatchType: matchType,
+			Score:     score,
+			Relation:  domain.ConflictRoleClient,
+			Name:      client.Name,
+			Document:  client.Document,
+			ClientID:  client.ID,
+		})
+	}
+
+	cases, err := s.caseRepo.FindConflictCandidates(subject.Document, tokens)
+	if err != nil {
+		return err
+	}
+	for _, case_ := range cases {
+		clientSide := representedSide(case_)
+		for _, party := range case_.Parties {
+			partyName := party.SearchName
+			if partyName == "" {
+				partyName = validation.NormalizeName(party.Name)
+			}
+			matchType, score := matchPerson(subject.Document, name, party.Document, partyName)
+			if matchType == "" {
+				continue
+			}
+
+			relation := domain.ConflictRoleOpposing
+			if (!party.ClientID.IsZero() && party.ClientID == case_.ClientID) || (clientSide != "" && party.Side == clientSide) {
+				relation = domain.ConflictRoleClient
+			}
+			addMatch(report, domain.ConflictMatch{
+				Subject:    subject,
+				Source:     domain.ConflictSourceCaseParty,
+				MatchType:  matchType,
+				Score:      score,
+				Relation:   relation,
+				Name:       party.Name,
+				Document:   party.Document,
+				ClientID:   party.ClientID,
+				CaseID:     case_.ID,
+				CaseNumber: case_.Number,
+				CaseTitle:  case_.Title,
+				PartySide:  party.Side,
+			})
+		}
+	}
+
+	return nil
+}
+
+func addMatch(report *domain.ConflictReport, match domain.ConflictMatch) {
+	match.Conflict = match.Subject.Role != match.Relation
+	if match.Conflict {
+		report.HasConflicts = true
+	}
+	report.Matches = append(report.Matches, match)
+}
+
+// representedSide retorna o polo do cliente do escritório no processo, se conhecido
+func representedSide(case_ *domain.Case) domain.PartySide {
+	for _, party := range case_.Parties {
+		if !party.ClientID.IsZero() && party.ClientID == case_.ClientID {
+			return party.Side
+		}
+	}
+	return ""
+}
+
+// matchPerson compara duas pessoas. Documentos diferentes indicam pessoas
+// diferentes mesmo com nomes iguais (homônimos).
+func matchPerson(document, name, otherDocument, otherName string) (string, float64) {
+	if document != "" && otherDocument != "" {
+		if document == otherDocument {
+			return domain.ConflictMatchDocument, 1
+		}
+		return "", 0
+	}
+	if name == "" || otherName == "" {
+		return "", 0
+	}
+	if name == otherName {
+		return domain.ConflictMatchExactName, 1
+	}
+	if score := nameSimilarity(name, otherName); score >= similarNameThreshold {
+		return domain.ConflictMatchSimilarName, score
+	}
+	return "", 0
+}
+
+// nameSimilarity combina a distância de edição (erros de digitação), também
+// sem partículas e sufixos societários ("Acme Ltda" e "Acme S/A"), com a
+// contenção de palavras (nomes abreviados, como "Maria Silva" e "Maria da Silva Santos")
+func nameSimilarity(a, b string) float64 {
+	score := levenshteinRatio(a, b)
+
+	tokensA, tokensB := significantTokens(a), significantTokens(b)
+	if len(tokensA) > 0 && len(tokensB) > 0 {
+		if stripped := levenshteinRatio(strings.Join(tokensA, " "), strings.Join(tokensB, " ")); stripped > score {
+			score = stripped
+		}
+	}
+	if len(tokensA) > len(tokensB) {
+		tokensA, tokensB = tokensB, tokensA
+	}
+	if len(tokensA) >= 2 {
+		present := make(map[string]bool, len(tokensB))
+		for _, token := range tokensB {
+			present[token] = true
+		}
+		common := 0
+		for _, token := range tokensA {
+			if present[token] {
+				common++
+			}
+		}
+		// Contenção total vale um pouco menos que um nome idêntico
+		if containment := 0.9 * float64(common) / float64(len(tokensA)); containment > score {
+			score = containment
+		}
+	}
+
+	return score
+}
+
+func significantTokens(name string) []string {
+	tokens := []string{}
+	for _, token := range strings.Fields(name) {
+		if len([]rune(token)) < 2 || nameStopwords[token] {
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+func levenshteinRatio(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 1
+	}
+
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return 1 - float64(previous[len(rb)])/float64(longest)
+}
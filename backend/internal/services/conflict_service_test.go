@@ -0,0 +1,326 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/validation"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// conflictClientStub devolve sempre os mesmos candidatos; a filtragem fica com o serviço
+type conflictClientStub struct {
+	domain.ClientRepository
+	clients []*domain.Client
+}
+
+func (r conflictClientStub) FindByID(id string) (*domain.Client, error) {
+	for _, client := range r.clients {
+		if client.ID.Hex() == id {
+			return client, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r conflictClientStub) FindConflictCandidates(document string, nameTokens []string) ([]*domain.Client, error) {
+	return r.clients, nil
+}
+
+type conflictCaseStub struct {
+	domain.CaseRepository
+	cases []*domain.Case
+}
+
+func (r conflictCaseStub) FindConflictCandidates(document string, nameTokens []string) ([]*domain.Case, error) {
+	return r.cases, nil
+}
+
+type conflictCheckStub struct {
+	domain.ConflictCheckRepository
+	created []*domain.ConflictCheck
+}
+
+func (r *conflictCheckStub) Create(check *domain.ConflictCheck) error {
+	r.created = append(r.created, check)
+	return nil
+}
+
+var (
+	acmeID     = primitive.NewObjectID()
+	joaoID     = primitive.NewObjectID()
+	robertoID  = primitive.NewObjectID()
+	acmeCaseID = primitive.NewObjectID()
+)
+
+func conflictFixture() *ConflictService {
+	clients := []*domain.Client{
+		{ID: acmeID, Name: "Acme Comércio Ltda", Document: "11222333000181"},
+		{ID: joaoID, Name: "João da Silva", Document: "52998224725"},
+		{ID: robertoID, Name: "Roberto Carlos Lima"},
+	}
+	for _, client := range clients {
+		client.SearchName = validation.NormalizeName(client.Name)
+	}
+
+	cases := []*domain.Case{{
+		ID:       acmeCaseID,
+		Number:   "0710802-55.2018.8.02.0001",
+		ClientID: acmeID,
+		Parties: []domain.CaseParty{
+			{Name: "Acme Comércio Ltda", Document: "11222333000181", ClientID: acmeID, Side: domain.PartySidePlaintiff},
+			{Name: "Construtora Horizonte Ltda", Side: domain.PartySideDefendant},
+			{Name: "Pedro Alves", Document: "11144477735", Side: domain.PartySideDefendant},
+		},
+	}}
+
+	return NewConflictService(conflictClientStub{clients: clients}, conflictCaseStub{cases: cases}, nil)
+}
+
+// expectedMatch resume uma coincidência: nome encontrado, tipo e se há conflito
+type expectedMatch struct {
+	name      string
+	matchType string
+	conflict  bool
+}
+
+func summarize(report *domain.ConflictReport) []expectedMatch {
+	matches := []expectedMatch{}
+	for _, match := range report.Matches {
+		matches = append(matches, expectedMatch{match.Name, match.MatchType, match.Conflict})
+	}
+	return matches
+}
+
+func assertMatches(t *testing.T, report *domain.ConflictReport, want []expectedMatch) {
+	t.Helper()
+	got := summarize(report)
+	if len(got) != len(want) {
+		t.Fatalf("coincidências = %+v, esperado %+v", got, want)
+	}
+	hasConflicts := false
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("coincidência %d = %+v, esperado %+v", i, got[i], want[i])
+		}
+		hasConflicts = hasConflicts || want[i].conflict
+	}
+	if report.HasConflicts != hasConflicts {
+		t.Errorf("HasConflicts = %v, esperado %v", report.HasConflicts, hasConflicts)
+	}
+}
+
+func TestNameSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		similar bool
+	}{
+		// Erros de digitação
+		{"João da Silva", "Joao da Silv", true},
+		{"Pedro Alves", "Pedro Alvez", true},
+		{"Fernanda Oliveira", "Fernando Oliveira", true},
+		// Sufixos societários e partículas
+		{"Acme Ltda", "ACME S/A", true},
+		// Contenção de palavras
+		{"Maria Silva", "Maria da Silva Santos", true},
+		{"Construtora Horizonte Ltda", "Construtora Horizonte Azul Ltda", true},
+		// Logo abaixo do limite (0,84)
+		{"Roberto Carlos Lima", "Roberta Carla Lima", false},
+		{"Maria Silva", "Mario Silveira", false},
+		{"Banco Alfa", "Banco Beta", false},
+		{"Ana Souza", "Ana Costa", false},
+	}
+
+	for _, tt := range tests {
+		a, b := validation.NormalizeName(tt.a), validation.NormalizeName(tt.b)
+		score := nameSimilarity(a, b)
+		if (score >= similarNameThreshold) != tt.similar {
+			t.Errorf("nameSimilarity(%q, %q) = %.4f, esperado parecido = %v", tt.a, tt.b, score, tt.similar)
+		}
+	}
+}
+
+func TestConflictCheck(t *testing.T) {
+	service := conflictFixture()
+
+	tests := []struct {
+		name    string
+		subject domain.ConflictSubject
+		want    []expectedMatch
+	}{
+		{
+			name:    "futuro cliente é parte contrária em processo",
+			subject: domain.ConflictSubject{Name: "Construtora Horizonte Azul Ltda", Role: domain.ConflictRoleClient},
+			want:    []expectedMatch{{"Construtora Horizonte Ltda", domain.ConflictMatchSimilarName, true}},
+		},
+		{
+			name:    "parte contrária é cliente do escritório",
+			subject: domain.ConflictSubject{Name: "ACME Comercio S/A", Role: domain.ConflictRoleOpposing},
+			want: []expectedMatch{
+				{"Acme Comércio Ltda", domain.ConflictMatchSimilarName, true},
+				{"Acme Comércio Ltda", domain.ConflictMatchSimilarName, true},
+			},
+		},
+		{
+			name:    "documento com máscara",
+			subject: domain.ConflictSubject{Name: "Joao Silva", Document: "529.982.247-25", Role: domain.ConflictRoleClient},
+			want:    []expectedMatch{{"João da Silva", domain.ConflictMatchDocument, false}},
+		},
+		{
+			name:    "documento sem máscara contra parte contrária",
+			subject: domain.ConflictSubject{Name: "P. Alves", Document: "11144477735", Role: domain.ConflictRoleClient},
+			want:    []expectedMatch{{"Pedro Alves", domain.ConflictMatchDocument, true}},
+		},
+		{
+			name:    "mesmo lado não é conflito",
+			subject: domain.ConflictSubject{Name: "Pedro Alvez", Role: domain.ConflictRoleOpposing},
+			want:    []expectedMatch{{"Pedro Alves", domain.ConflictMatchSimilarName, false}},
+		},
+		{
+			name:    "homônimo com outro documento",
+			subject: domain.ConflictSubject{Name: "Pedro Alves", Document: "12345678909", Role: domain.ConflictRoleClient},
+			want:    []expectedMatch{},
+		},
+		{
+			name:    "nome abaixo do limite",
+			subject: domain.ConflictSubject{Name: "Roberta Carla Lima", Role: domain.ConflictRoleOpposing},
+			want:    []expectedMatch{},
+		},
+		{
+			name:    "nome idêntico",
+			subject: domain.ConflictSubject{Name: "ROBERTO CARLOS LIMA", Role: domain.ConflictRoleOpposing},
+			want:    []expectedMatch{{"Roberto Carlos Lima", domain.ConflictMatchExactName, true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := service.Check([]domain.ConflictSubject{tt.subject})
+			if err != nil {
+				t.Fatalf("Check: %v", err)
+			}
+			assertMatches(t, report, tt.want)
+		})
+	}
+}
+
+func TestConflictCheckValidation(t *testing.T) {
+	service := conflictFixture()
+
+	for _, subjects := range [][]domain.ConflictSubject{
+		nil,
+		{{Name: "  "}},
+		{{Name: "Pedro Alves", Document: "111.444.777-36"}},
+		{{Name: "Pedro Alves", Role: "testemunha"}},
+	} {
+		if _, err := service.Check(subjects); !IsValidationError(err) {
+			t.Errorf("Check(%+v) = %v, esperado erro de validação", subjects, err)
+		}
+	}
+}
+
+func TestConflictCheckCaseSides(t *testing.T) {
+	service := conflictFixture()
+
+	// Novo processo do João contra a Acme: a Acme passa a ser parte contrária
+	report, err := service.CheckCase(&domain.Case{
+		ClientID: joaoID,
+		Parties: []domain.CaseParty{
+			{Name: "João da Silva", Document: "52998224725", ClientID: joaoID, Side: domain.PartySidePlaintiff},
+			{Name: "Acme Comércio Ltda", Document: "11222333000181", Side: domain.PartySideDefendant},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CheckCase: %v", err)
+	}
+	assertMatches(t, report, []expectedMatch{
+		{"João da Silva", domain.ConflictMatchDocument, false},
+		{"Acme Comércio Ltda", domain.ConflictMatchDocument, true},
+		{"Acme Comércio Ltda", domain.ConflictMatchDocument, true},
+	})
+}
+
+func TestConflictCheckExcludesTarget(t *testing.T) {
+	service := conflictFixture()
+	parties := []domain.CaseParty{
+		{Name: "Acme Comércio Ltda", Document: "11222333000181", ClientID: acmeID, Side: domain.PartySidePlaintiff},
+		{Name: "Construtora Horizonte Ltda", Side: domain.PartySideDefendant},
+	}
+
+	// Um novo processo com as mesmas partes encontra o processo existente
+	report, err := service.CheckCase(&domain.Case{ClientID: acmeID, Parties: parties})
+	if err != nil {
+		t.Fatalf("CheckCase: %v", err)
+	}
+	assertMatches(t, report, []expectedMatch{
+		{"Acme Comércio Ltda", domain.ConflictMatchDocument, false},
+		{"Acme Comércio Ltda", domain.ConflictMatchDocument, false},
+		{"Construtora Horizonte Ltda", domain.ConflictMatchExactName, false},
+	})
+
+	// Na edição, o próprio processo é desconsiderado
+	report, err = service.CheckCase(&domain.Case{ID: acmeCaseID, ClientID: acmeID, Parties: parties})
+	if err != nil {
+		t.Fatalf("CheckCase: %v", err)
+	}
+	assertMatches(t, report, []expectedMatch{{"Acme Comércio Ltda", domain.ConflictMatchDocument, false}})
+
+	// Na edição do cliente, só o próprio cadastro sai; o processo continua
+	report, err = service.CheckClient(&domain.Client{ID: acmeID, Name: "Acme Comércio Ltda", Document: "11222333000181"})
+	if err != nil {
+		t.Fatalf("CheckClient: %v", err)
+	}
+	assertMatches(t, report, []expectedMatch{{"Acme Comércio Ltda", domain.ConflictMatchDocument, false}})
+}
+
+func TestConflictCheckClientExcludesOnlyItself(t *testing.T) {
+	service := conflictFixture()
+
+	// Outro cliente com o nome parecido ao da Acme continua aparecendo
+	report, err := service.CheckClient(&domain.Client{ID: primitive.NewObjectID(), Name: "Acme Comercio S/A"})
+	if err != nil {
+		t.Fatalf("CheckClient: %v", err)
+	}
+	assertMatches(t, report, []expectedMatch{
+		{"Acme Comércio Ltda", domain.ConflictMatchSimilarName, false},
+		{"Acme Comércio Ltda", domain.ConflictMatchSimilarName, false},
+	})
+}
+
+func TestConflictEnforce(t *testing.T) {
+	checks := &conflictCheckStub{}
+	service := NewConflictService(conflictClientStub{}, conflictCaseStub{}, checks)
+	report := &domain.ConflictReport{HasConflicts: true}
+	userID := primitive.NewObjectID()
+
+	if check, err := service.Enforce(domain.ConflictTargetCase, &domain.ConflictReport{}, nil, userID); check != nil || err != nil {
+		t.Errorf("sem conflito: Enforce = %v, %v", check, err)
+	}
+	for _, override := range []*domain.ConflictOverride{nil, {Reason: "cliente ciente"}, {Acknowledged: true, Reason: "  "}} {
+		if _, err := service.Enforce(domain.ConflictTargetCase, report, override, userID); !errors.Is(err, ErrConflictOfInterest) {
+			t.Errorf("Enforce(%+v) = %v, esperado ErrConflictOfInterest", override, err)
+		}
+	}
+
+	override := &domain.ConflictOverride{Acknowledged: true, Reason: " cliente ciente "}
+	check, err := service.Enforce(domain.ConflictTargetCase, report, override, userID)
+	if err != nil {
+		t.Fatalf("Enforce: %v", err)
+	}
+	// Nada é gravado antes de o cadastro ser salvo
+	if len(checks.created) != 0 {
+		t.Fatal("o override não deve ser gravado antes do cadastro")
+	}
+	if override.CheckID != check.ID || override.Reason != "cliente ciente" || override.AcknowledgedBy != userID {
+		t.Errorf("override = %+v", override)
+	}
+
+	targetID := primitive.NewObjectID()
+	if err := service.Record(check, targetID); err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+	if len(checks.created) != 1 || checks.created[0].ID != override.CheckID || checks.created[0].TargetID != targetID {
+		t.Errorf("override gravado = %+v", checks.created)
+	}
+}
@@ -0,0 +1,258 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/validation"
+)
+
+// maxTermDays limita o prazo, em dias, aceito no cálculo
+const maxTermDays = 365
+
+type DeadlineService struct {
+	deadlineRepo domain.DeadlineRepository
+	caseRepo     domain.CaseRepository
+	userRepo     domain.UserRepository
+	calendar     *calendar.Calendar
+}
+
+func NewDeadlineService(deadlineRepo domain.DeadlineRepository, caseRepo domain.CaseRepository, userRepo domain.UserRepository, cal *calendar.Calendar) *DeadlineService {
+	return &DeadlineService{deadlineRepo: deadlineRepo, caseRepo: caseRepo, userRepo: userRepo, calendar: cal}
+}
+
+// Calculate calcula o vencimento sem gravar o prazo
+func (s *DeadlineService) Calculate(params domain.DeadlineCalculation) (*domain.DeadlineResult, error) {
+	return s.calculate(&params)
+}
+
+// calculate aplica a contagem do CPC: o dia do começo é excluído e a contagem
+// inicia no primeiro dia útil seguinte à intimação ou publicação (art. 224);
+// em dias úteis, só contam dias úteis (art. 219); em dias corridos, contam
+// todos os dias exceto os do recesso, que suspende o prazo (art. 220), e o
+// vencimento em dia não útil é prorrogado para o primeiro dia útil seguinte
+func (s *DeadlineService) calculate(params *domain.DeadlineCalculation) (*domain.DeadlineResult, error) {
+	if params.StartEvent == "" {
+		params.StartEvent = domain.DeadlineStartIntimation
+	}
+	if params.CountingMode == "" {
+		params.CountingMode = domain.DeadlineCountingBusinessDays
+	}
+	params.State = strings.ToUpper(strings.TrimSpace(params.State))
+	params.Court = strings.TrimSpace(params.Court)
+
+	if !params.StartEvent.IsValid() {
+		return nil, newValidationError("evento de início inválido: %s", params.StartEvent)
+	}
+	if !params.CountingMode.IsValid() {
+		return nil, newValidationError("modo de contagem inválido: %s", params.CountingMode)
+	}
+	if params.StartDate.IsZero() {
+		return nil, newValidationError("data de início do prazo é obrigatória")
+	}
+	if params.TermDays < 1 || params.TermDays > maxTermDays {
+		return nil, newValidationError("prazo deve ter entre 1 e %d dias", maxTermDays)
+	}
+	if params.State != "" && len(params.State) != 2 {
+		return nil, newValidationError("UF deve ter 2 letras")
+	}
+	params.StartDate = calendar.Date(params.StartDate)
+
+	lookup := s.calendar.For(params.State, params.Court)
+	result := &domain.DeadlineResult{NonWorkingDays: []domain.NonWorkingDay{}}
+
+	reference := params.StartDate
+	working, err := lookup.IsWorkingDay(reference)
+	if err != nil {
+		return nil, err
+	}
+	// A publicação é o primeiro dia útil seguinte à disponibilização no diário
+	// eletrônico; a intimação em dia não útil vale no primeiro dia útil seguinte
+	if params.StartEvent == domain.DeadlineStartAvailability || !working {
+		next, skipped, err := lookup.NextWorkingDay(reference)
+		if err != nil {
+			return nil, err
+		}
+		reference = next
+		result.NonWorkingDays = append(result.NonWorkingDays, skipped...)
+	}
+	result.ReferenceDate = reference
+
+	first, skipped, err := lookup.NextWorkingDay(reference)
+	if err != nil {
+		return nil, err
+	}
+	result.StartsOn = first
+	result.NonWorkingDays = append(result.NonWorkingDays, skipped...)
+
+	term := params.TermDays
+	if params.Doubled {
+		term *= 2
+	}
+
+	due := first
+	switch params.CountingMode {
+	case domain.DeadlineCountingBusinessDays:
+		for counted := 1; counted < term; counted++ {
+			next, skipped, err := lookup.NextWorkingDay(due)
+			if err != nil {
+				return nil, err
+			}
+			due = next
+			result.NonWorkingDays = append(result.NonWorkingDays, skipped...)
+		}
+	case domain.DeadlineCountingCalendarDays:
+		for counted := 1; counted < term; {
+			due = due.AddDate(0, 0, 1)
+			if calendar.IsRecess(due) {
+				result.NonWorkingDays = append(result.NonWorkingDays, domain.NonWorkingDay{Date: due, Reason: calendar.ReasonRecess})
+				continue
+			}
+			counted++
+		}
+		reason, err := lookup.Reason(due)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			next, skipped, err := lookup.NextWorkingDay(due)
+			if err != nil {
+				return nil, err
+			}
+			result.NonWorkingDays = append(result.NonWorkingDays, domain.NonWorkingDay{Date: due, Reason: reason})
+			result.NonWorkingDays = append(result.NonWorkingDays, skipped...)
+			due = next
+		}
+	}
+	result.DueDate = due
+
+	return result, nil
+}
+
+// applyJurisdiction usa a UF e o órgão do número CNJ do processo quando o
+// prazo não informa outro juízo
+func applyJurisdiction(params *domain.DeadlineCalculation, case_ *domain.Case) {
+	if params.State != "" || params.Court != "" || case_.NumberInfo == nil {
+		return
+	}
+	params.State = validation.CNJState(case_.NumberInfo)
+	params.Court = validation.CNJCourtCode(case_.NumberInfo)
+}
+
+func (s *DeadlineService) validate(deadline *domain.Deadline, case_ *domain.Case) error {
+	deadline.Title = strings.TrimSpace(deadline.Title)
+	if deadline.Title == "" {
+		return newValidationError("título do prazo é obrigatório")
+	}
+	if len(deadline.Title) > 200 {
+		return newValidationError("título do prazo deve ter no máximo 200 caracteres")
+	}
+	if !deadline.Status.IsValid() {
+		return newValidationError("status do prazo inválido: %s", deadline.Status)
+	}
+
+	// Sem responsável informado, o prazo fica com o advogado do processo
+	if deadline.AssignedTo.IsZero() {
+		deadline.AssignedTo = case_.LawyerID
+	} else if _, err := s.userRepo.FindByID(deadline.AssignedTo.Hex()); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return newValidationError("responsável pelo prazo não encontrado")
+		}
+		return err
+	}
+
+	applyJurisdiction(&deadline.DeadlineCalculation, case_)
+	result, err := s.calculate(&deadline.DeadlineCalculation)
+	if err != nil {
+		return err
+	}
+	deadline.DeadlineResult = *result
+
+	return nil
+}
+
+func (s *DeadlineService) Create(deadline *domain.Deadline) error {
+	case_, err := s.caseRepo.FindByID(deadline.CaseID.Hex())
+	if err != nil {
+		return err
+	}
+
+	deadline.Status = domain.DeadlineStatusPending
+	deadline.CompletedAt = nil
+	if err := s.validate(deadline, case_); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	deadline.CreatedAt = now
+	deadline.UpdatedAt = now
+
+	return s.deadlineRepo.Create(deadline)
+}
+
+// GetByID busca o prazo garantindo que pertença ao processo informado
+func (s *DeadlineService) GetByID(caseID, id string) (*domain.Deadline, error) {
+	deadline, err := s.deadlineRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if deadline.CaseID.Hex() != caseID {
+		return nil, repositories.ErrDeadlineNotFound
+	}
+	return deadline, nil
+}
+
+func (s *DeadlineService) GetByCaseID(caseID string, status domain.DeadlineStatus) ([]*domain.Deadline, error) {
+	if status != "" && !status.IsValid() {
+		return nil, newValidationError("status do prazo inválido: %s", status)
+	}
+	if _, err := s.caseRepo.FindByID(caseID); err != nil {
+		return nil, err
+	}
+	return s.deadlineRepo.FindByCaseID(caseID, status)
+}
+
+// Update recalcula o vencimento com os parâmetros atuais e registra quando o
+// prazo foi cumprido
+func (s *DeadlineService) Update(deadline *domain.Deadline) error {
+	existing, err := s.GetByID(deadline.CaseID.Hex(), deadline.ID.Hex())
+	if err != nil {
+		return err
+	}
+	case_, err := s.caseRepo.FindByID(existing.CaseID.Hex())
+	if err != nil {
+		return err
+	}
+
+	if err := s.validate(deadline, case_); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	switch {
+	case deadline.Status != domain.DeadlineStatusDone:
+		deadline.CompletedAt = nil
+	case existing.Status != domain.DeadlineStatusDone:
+		deadline.CompletedAt = &now
+	default:
+		deadline.CompletedAt = existing.CompletedAt
+	}
+
+	// Preservar os dados de criação originais
+	deadline.CreatedAt = existing.CreatedAt
+	deadline.CreatedBy = existing.CreatedBy
+	deadline.UpdatedAt = now
+
+	return s.deadlineRepo.Update(deadline)
+}
+
+func (s *DeadlineService) Delete(caseID, id string) error {
+	if _, err := s.GetByID(caseID, id); err != nil {
+		return err
+	}
+	return s.deadlineRepo.Delete(id)
+}
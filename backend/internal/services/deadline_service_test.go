@@ -0,0 +1,88 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+)
+
+// noHolidays é um cadastro de feriados vazio: valem só os feriados nacionais
+type noHolidays struct {
+	domain.HolidayRepository
+}
+
+func (noHolidays) FindBetween(from, to time.Time) ([]*domain.Holiday, error) {
+	return nil, nil
+}
+
+func mustDate(t *testing.T, value string) time.Time {
+	t.Helper()
+	date, err := calendar.ParseDate(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return date
+}
+
+func TestDeadlineCalculate(t *testing.T) {
+	service := NewDeadlineService(nil, nil, nil, calendar.New(noHolidays{}))
+
+	tests := []struct {
+		name     string
+		event    domain.DeadlineStartEvent
+		start    string
+		days     int
+		mode     domain.DeadlineCountingMode
+		doubled  bool
+		startsOn string
+		due      string
+	}{
+		{"15 dias úteis", domain.DeadlineStartIntimation, "2025-03-10", 15, domain.DeadlineCountingBusinessDays, false, "2025-03-11", "2025-03-31"},
+		{"intimação no sábado", domain.DeadlineStartIntimation, "2025-03-08", 15, domain.DeadlineCountingBusinessDays, false, "2025-03-11", "2025-03-31"},
+		{"disponibilização na sexta", domain.DeadlineStartAvailability, "2025-03-07", 15, domain.DeadlineCountingBusinessDays, false, "2025-03-11", "2025-03-31"},
+		{"prazo em dobro", domain.DeadlineStartIntimation, "2025-03-10", 5, domain.DeadlineCountingBusinessDays, true, "2025-03-11", "2025-03-24"},
+		{"feriado nacional no início", domain.DeadlineStartIntimation, "2025-11-19", 3, domain.DeadlineCountingBusinessDays, false, "2025-11-21", "2025-11-25"},
+		{"Semana Santa e Tiradentes", domain.DeadlineStartIntimation, "2025-04-14", 5, domain.DeadlineCountingBusinessDays, false, "2025-04-15", "2025-04-23"},
+		{"dias úteis no recesso", domain.DeadlineStartIntimation, "2025-12-18", 5, domain.DeadlineCountingBusinessDays, false, "2025-12-19", "2026-01-26"},
+		{"corridos com vencimento no sábado", domain.DeadlineStartIntimation, "2025-03-10", 5, domain.DeadlineCountingCalendarDays, false, "2025-03-11", "2025-03-17"},
+		{"corridos suspensos no recesso", domain.DeadlineStartIntimation, "2025-12-15", 10, domain.DeadlineCountingCalendarDays, false, "2025-12-16", "2026-01-26"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.Calculate(domain.DeadlineCalculation{
+				StartEvent:   tt.event,
+				StartDate:    mustDate(t, tt.start),
+				TermDays:     tt.days,
+				CountingMode: tt.mode,
+				Doubled:      tt.doubled,
+			})
+			if err != nil {
+				t.Fatalf("Calculate: %v", err)
+			}
+			if got := result.StartsOn.Format(calendar.DateLayout); got != tt.startsOn {
+				t.Errorf("início = %s, esperado %s", got, tt.startsOn)
+			}
+			if got := result.DueDate.Format(calendar.DateLayout); got != tt.due {
+				t.Errorf("vencimento = %s, esperado %s", got, tt.due)
+			}
+		})
+	}
+}
+
+func TestDeadlineCalculateValidation(t *testing.T) {
+	service := NewDeadlineService(nil, nil, nil, calendar.New(noHolidays{}))
+
+	for _, params := range []domain.DeadlineCalculation{
+		{StartDate: mustDate(t, "2025-03-10"), TermDays: 0},
+		{TermDays: 15},
+		{StartDate: mustDate(t, "2025-03-10"), TermDays: 15, State: "SPO"},
+		{StartDate: mustDate(t, "2025-03-10"), TermDays: 15, CountingMode: "semanas"},
+	} {
+		if _, err := service.Calculate(params); !IsValidationError(err) {
+			t.Errorf("Calculate(%+v) = %v, esperado erro de validação", params, err)
+		}
+	}
+}
@@ -0,0 +1,423 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// mimeSniffSize é a quantidade de bytes lida do início do arquivo para detectar o tipo
+const mimeSniffSize = 3072
+
+type DocumentService struct {
+	documentRepo domain.DocumentRepository
+	versionRepo  domain.DocumentVersionRepository
+	caseRepo     domain.CaseRepository
+	storage      storage.Storage
+}
+
+func NewDocumentService(documentRepo domain.DocumentRepository, versionRepo domain.DocumentVersionRepository, caseRepo domain.CaseRepository, fileStorage storage.Storage) *DocumentService {
+	return &DocumentService{documentRepo: documentRepo, versionRepo: versionRepo, caseRepo: caseRepo, storage: fileStorage}
+}
+
+func (s *DocumentService) validate(document *domain.Document) error {
+	document.Title = strings.TrimSpace(document.Title)
+	if document.Title == "" {
+		return newValidationError("título do documento é obrigatório")
+	}
+	if document.CaseID.IsZero() {
+		return newValidationError("processo do documento é obrigatório")
+	}
+
+	// O documento precisa pertencer a um processo existente
+	if _, err := s.caseRepo.FindByID(document.CaseID.Hex()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Create registra um documento sem arquivo armazenado, apenas com URL externa
+func (s *DocumentService) Create(document *domain.Document) error {
+	if err := s.validate(document); err != nil {
+		return err
+	}
+	if strings.TrimSpace(document.URL) == "" {
+		return newValidationError("URL do documento é obrigatória")
+	}
+
+	now := time.Now()
+	document.CreatedAt = now
+	document.UpdatedAt = now
+
+	return s.documentRepo.Create(document)
+}
+
+// Upload armazena o arquivo e registra o documento com seus metadados
+func (s *DocumentService) Upload(document *domain.Document, file domain.FileUpload) error {
+	if err := s.validate(document); err != nil {
+		return err
+	}
+
+	document.ID = primitive.NewObjectID()
+	stored, err := s.storeFile(document.CaseID, document.ID, file)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	version := stored.version(document.ID, 1, document.CreatedBy, now)
+	applyVersion(document, version)
+	document.URL = fmt.Sprintf("/api/documents/%s/download", document.ID.Hex())
+	document.CreatedAt = now
+
+	if err := s.documentRepo.Create(document); err != nil {
+		s.removeOrphan(stored.StorageKey)
+		return err
+	}
+
+	if err := s.versionRepo.Create(version); err != nil {
+		if delErr := s.documentRepo.Purge(document.ID.Hex()); delErr != nil {
+			log.Printf("Aviso: falha ao desfazer documento %s: %v", document.ID.Hex(), delErr)
+		}
+		s.removeOrphan(stored.StorageKey)
+		return err
+	}
+
+	return nil
+}
+
+// AddVersion grava um novo arquivo como próxima versão do documento, sem
+// sobrescrever as versões anteriores
+func (s *DocumentService) AddVersion(id string, file domain.FileUpload, comment string, createdBy primitive.ObjectID) (*domain.Document, error) {
+	document, err := s.documentRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureInitialVersion(document); err != nil {
+		return nil, err
+	}
+
+	stored, err := s.storeFile(document.CaseID, document.ID, file)
+	if err != nil {
+		return nil, err
+	}
+
+	version := stored.version(document.ID, document.Version+1, createdBy, time.Now())
+	version.Comment = strings.TrimSpace(comment)
+	if err := s.versionRepo.Create(version); err != nil {
+		s.removeOrphan(stored.StorageKey)
+		return nil, err
+	}
+
+	applyVersion(document, version)
+	if err := s.documentRepo.Update(document); err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// GetVersions lista as versões do documento, da mais recente para a mais antiga
+func (s *DocumentService) GetVersions(id string) ([]*domain.DocumentVersion, error) {
+	document, err := s.documentRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureInitialVersion(document); err != nil {
+		return nil, err
+	}
+	return s.versionRepo.FindByDocumentID(id)
+}
+
+// OpenVersion retorna os metadados e o conteúdo de uma versão específica
+func (s *DocumentService) OpenVersion(id string, version int) (*domain.DocumentVersion, io.ReadCloser, error) {
+	document, err := s.documentRepo.FindByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.ensureInitialVersion(document); err != nil {
+		return nil, nil, err
+	}
+
+	found, err := s.versionRepo.FindVersion(id, version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.storage.Get(context.Background(), found.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return found, content, nil
+}
+
+// RestoreVersion torna uma versão anterior a atual criando uma nova versão
+// com o mesmo arquivo, preservando o histórico
+func (s *DocumentService) RestoreVersion(id string, version int, createdBy primitive.ObjectID) (*domain.Document, error) {
+	document, err := s.documentRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureInitialVersion(document); err != nil {
+		return nil, err
+	}
+
+	source, err := s.versionRepo.FindVersion(id, version)
+	if err != nil {
+		return nil, err
+	}
+	if source.Version == document.Version {
+		return nil, newValidationError("a versão %d já é a versão atual", version)
+	}
+
+	restored := *source
+	restored.Version = document.Version + 1
+	restored.Comment = fmt.Sprintf("restauração da versão %d", source.Version)
+	restored.RestoredFrom = source.Version
+	restored.CreatedBy = createdBy
+	restored.CreatedAt = time.Now()
+	if err := s.versionRepo.Create(&restored); err != nil {
+		return nil, err
+	}
+
+	applyVersion(document, &restored)
+	if err := s.documentRepo.Update(document); err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// ensureInitialVersion registra como versão 1 o arquivo de documentos
+// enviados antes do versionamento
+func (s *DocumentService) ensureInitialVersion(document *domain.Document) error {
+	if document.Version > 0 || document.StorageKey == "" {
+		return nil
+	}
+
+	version := &domain.DocumentVersion{
+		DocumentID: document.ID,
+		Version:    1,
+		FileName:   document.FileName,
+		StorageKey: document.StorageKey,
+		Size:       document.Size,
+		MimeType:   document.MimeType,
+		SHA256:     document.SHA256,
+		CreatedBy:  document.CreatedBy,
+		CreatedAt:  document.CreatedAt,
+	}
+	if err := s.versionRepo.Create(version); err != nil && !errors.Is(err, repositories.ErrDuplicateDocumentVersion) {
+		return err
+	}
+
+	document.Version = 1
+	return s.documentRepo.Update(document)
+}
+
+// applyVersion reflete a versão como estado atual do documento
+func applyVersion(document *domain.Document, version *domain.DocumentVersion) {
+	document.FileName = version.FileName
+	document.StorageKey = version.StorageKey
+	document.Size = version.Size
+	document.MimeType = version.MimeType
+	document.SHA256 = version.SHA256
+	document.Version = version.Version
+	document.UpdatedAt = version.CreatedAt
+}
+
+func (s *DocumentService) removeOrphan(key string) {
+	if err := s.storage.Delete(context.Background(), key); err != nil {
+		log.Printf("Aviso: falha ao remover arquivo órfão %s: %v", key, err)
+	}
+}
+
+// Open retorna os metadados e o conteúdo do arquivo do documento. O leitor
+// deve ser fechado pelo chamador.
+func (s *DocumentService) Open(id string) (*domain.Document, io.ReadCloser, error) {
+	document, err := s.documentRepo.FindByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if document.StorageKey == "" {
+		return nil, nil, storage.ErrObjectNotFound
+	}
+
+	content, err := s.storage.Get(context.Background(), document.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return document, content, nil
+}
+
+func (s *DocumentService) GetByID(id string) (*domain.Document, error) {
+	return s.documentRepo.FindByID(id)
+}
+
+func (s *DocumentService) GetByCaseID(caseID string) ([]*domain.Document, error) {
+	if _, err := s.caseRepo.FindByID(caseID); err != nil {
+		return nil, err
+	}
+	return s.documentRepo.FindByCaseID(caseID)
+}
+
+// Update altera apenas os dados descritivos do documento
+func (s *DocumentService) Update(document *domain.Document) error {
+	existing, err := s.documentRepo.FindByID(document.ID.Hex())
+	if err != nil {
+		return err
+	}
+
+	document.Title = strings.TrimSpace(document.Title)
+	if document.Title == "" {
+		return newValidationError("título do documento é obrigatório")
+	}
+
+	existing.Title = document.Title
+	existing.Description = document.Description
+	existing.UpdatedAt = time.Now()
+
+	if err := s.documentRepo.Update(existing); err != nil {
+		return err
+	}
+
+	*document = *existing
+	return nil
+}
+
+// Delete move o documento para a lixeira; os arquivos são mantidos até a
+// remoção definitiva
+func (s *DocumentService) Delete(id string, deletedBy primitive.ObjectID) error {
+	return s.documentRepo.Delete(id, deletedBy)
+}
+
+// Purge remove definitivamente o documento, na lixeira ou não, com todas as
+// versões e arquivos
+func (s *DocumentService) Purge(id string) error {
+	document, err := s.documentRepo.FindDeletedByID(id)
+	if errors.Is(err, repositories.ErrDocumentNotFound) {
+		document, err = s.documentRepo.FindByID(id)
+	}
+	if err != nil {
+		return err
+	}
+
+	versions, err := s.versionRepo.FindByDocumentID(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.documentRepo.Purge(id); err != nil {
+		return err
+	}
+	if err := s.versionRepo.DeleteByDocumentID(id); err != nil {
+		return err
+	}
+
+	// Versões restauradas compartilham arquivos; remover cada arquivo uma vez
+	keys := map[string]bool{document.StorageKey: document.StorageKey != ""}
+	for _, version := range versions {
+		keys[version.StorageKey] = true
+	}
+	for key, remove := range keys {
+		if !remove {
+			continue
+		}
+		if err := s.storage.Delete(context.Background(), key); err != nil && !errors.Is(err, storage.ErrObjectNotFound) {
+			log.Printf("Aviso: falha ao remover arquivo %s: %v", key, err)
+		}
+	}
+
+	return nil
+}
+
+// storedFile descreve um arquivo gravado no armazenamento
+type storedFile struct {
+	FileName   string
+	StorageKey string
+	Size       int64
+	MimeType   string
+	SHA256     string
+}
+
+// storeFile grava o arquivo detectando o tipo MIME pelo conteúdo e
+// calculando o SHA-256 durante a transferência
+func (s *DocumentService) storeFile(caseID, documentID primitive.ObjectID, file domain.FileUpload) (*storedFile, error) {
+	fileName := filepath.Base(strings.TrimSpace(file.FileName))
+	if fileName == "" || fileName == "." || fileName == string(filepath.Separator) {
+		return nil, newValidationError("nome do arquivo é obrigatório")
+	}
+	if file.Content == nil {
+		return nil, newValidationError("arquivo é obrigatório")
+	}
+
+	header := make([]byte, mimeSniffSize)
+	n, err := io.ReadFull(file.Content, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	header = header[:n]
+	if n == 0 {
+		return nil, newValidationError("arquivo vazio")
+	}
+	mimeType := mimetype.Detect(header).String()
+
+	hash := sha256.New()
+	counter := &countingReader{}
+	counter.reader = io.TeeReader(io.MultiReader(bytes.NewReader(header), file.Content), hash)
+
+	key := fmt.Sprintf("cases/%s/documents/%s/%s", caseID.Hex(), documentID.Hex(), primitive.NewObjectID().Hex())
+	if err := s.storage.Put(context.Background(), key, counter, file.Size, mimeType); err != nil {
+		return nil, fmt.Errorf("falha ao armazenar arquivo: %v", err)
+	}
+
+	return &storedFile{
+		FileName:   fileName,
+		StorageKey: key,
+		Size:       counter.count,
+		MimeType:   mimeType,
+		SHA256:     hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+func (f *storedFile) version(documentID primitive.ObjectID, number int, createdBy primitive.ObjectID, at time.Time) *domain.DocumentVersion {
+	return &domain.DocumentVersion{
+		DocumentID: documentID,
+		Version:    number,
+		FileName:   f.FileName,
+		StorageKey: f.StorageKey,
+		Size:       f.Size,
+		MimeType:   f.MimeType,
+		SHA256:     f.SHA256,
+		CreatedBy:  createdBy,
+		CreatedAt:  at,
+	}
+}
+
+// countingReader contabiliza os bytes efetivamente lidos
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
@@ -0,0 +1,26 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ValidationError indica dados de entrada inválidos, que devem ser
+// reportados ao cliente como erro de requisição
+type ValidationError struct {
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func newValidationError(format string, args ...interface{}) error {
+	return &ValidationError{Message: fmt.Sprintf(format, args...)}
+}
+
+// IsValidationError informa se o erro é (ou envolve) um ValidationError
+func IsValidationError(err error) bool {
+	var validationErr *ValidationError
+	return errors.As(err, &validationErr)
+}
@@ -0,0 +1,323 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrEventOverlap é retornado quando o compromisso coincide com outro do
+// responsável ou dos participantes
+var ErrEventOverlap = errors.New("o compromisso conflita com outros compromissos dos envolvidos")
+
+// EventOverlapError carrega os compromissos que se sobrepõem ao novo horário
+type EventOverlapError struct {
+	Overlaps []*domain.Event
+}
+
+func (e *EventOverlapError) Error() string {
+	return ErrEventOverlap.Error()
+}
+
+func (e *EventOverlapError) Unwrap() error {
+	return ErrEventOverlap
+}
+
+const (
+	// maxRecurrenceOccurrences limita as ocorrências geradas por uma série
+	maxRecurrenceOccurrences = 100
+
+	// maxAgendaRangeDays limita o período das consultas de agenda
+	maxAgendaRangeDays = 366
+)
+
+type EventService struct {
+	eventRepo domain.EventRepository
+	caseRepo  domain.CaseRepository
+	userRepo  domain.UserRepository
+}
+
+func NewEventService(eventRepo domain.EventRepository, caseRepo domain.CaseRepository, userRepo domain.UserRepository) *EventService {
+	return &EventService{eventRepo: eventRepo, caseRepo: caseRepo, userRepo: userRepo}
+}
+
+func (s *EventService) validate(event *domain.Event) error {
+	if !event.Type.IsValid() {
+		return newValidationError("tipo de compromisso inválido: use audiencia, reuniao ou diligencia")
+	}
+	event.Title = strings.TrimSpace(event.Title)
+	if event.Title == "" {
+		return newValidationError("título do compromisso é obrigatório")
+	}
+	if len(event.Title) > 200 {
+		return newValidationError("título do compromisso deve ter no máximo 200 caracteres")
+	}
+	if !event.Status.IsValid() {
+		return newValidationError("status do compromisso inválido: %s", event.Status)
+	}
+	if event.StartAt.IsZero() || event.EndAt.IsZero() {
+		return newValidationError("início e término do compromisso são obrigatórios")
+	}
+	if !event.EndAt.After(event.StartAt) {
+		return newValidationError("o término deve ser posterior ao início do compromisso")
+	}
+
+	if event.Type == domain.EventTypeHearing && event.CaseID.IsZero() {
+		return newValidationError("audiências devem estar vinculadas a um processo")
+	}
+	if !event.CaseID.IsZero() {
+		case_, err := s.caseRepo.FindByID(event.CaseID.Hex())
+		if err != nil {
+			if errors.Is(err, repositories.ErrCaseNotFound) {
+				return newValidationError("processo não encontrado")
+			}
+			return err
+		}
+		// Sem responsável informado, o compromisso fica com o advogado do processo
+		if event.LawyerID.IsZero() {
+			event.LawyerID = case_.LawyerID
+		}
+	}
+	if event.LawyerID.IsZero() {
+		return newValidationError("responsável pelo compromisso é obrigatório")
+	}
+	if err := s.ensureUser(event.LawyerID, "responsável pelo compromisso não encontrado"); err != nil {
+		return err
+	}
+
+	participants := []primitive.ObjectID{}
+	seen := map[primitive.ObjectID]bool{event.LawyerID: true}
+	for _, participant := range event.Participants {
+		if participant.IsZero() || seen[participant] {
+			continue
+		}
+		seen[participant] = true
+		if err := s.ensureUser(participant, "participante não encontrado: "+participant.Hex()); err != nil {
+			return err
+		}
+		participants = append(participants, participant)
+	}
+	event.Participants = participants
+
+	return nil
+}
+
+func (s *EventService) ensureUser(id primitive.ObjectID, message string) error {
+	if _, err := s.userRepo.FindByID(id.Hex()); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return newValidationError("%s", message)
+		}
+		return err
+	}
+	return nil
+}
+
+func validateRecurrence(event *domain.Event) error {
+	recurrence := event.Recurrence
+	if event.Type != domain.EventTypeMeeting {
+		return newValidationError("apenas reuniões podem ser recorrentes")
+	}
+	if !recurrence.Frequency.IsValid() {
+		return newValidationError("periodicidade inválida: use daily, weekly ou monthly")
+	}
+	if recurrence.Interval == 0 {
+		recurrence.Interval = 1
+	}
+	if recurrence.Interval < 1 {
+		return newValidationError("intervalo da recorrência deve ser positivo")
+	}
+	if recurrence.Count < 0 || recurrence.Count > maxRecurrenceOccurrences {
+		return newValidationError("a recorrência deve ter entre 1 e %d ocorrências", maxRecurrenceOccurrences)
+	}
+	if recurrence.Count == 0 && recurrence.Until.IsZero() {
+		return newValidationError("informe o número de ocorrências ou a data final da recorrência")
+	}
+	if !recurrence.Until.IsZero() && recurrence.Until.Before(event.StartAt) {
+		return newValidationError("a data final da recorrência é anterior ao início do compromisso")
+	}
+	return nil
+}
+
+// occurrences expande um compromisso recorrente nas suas ocorrências, que são
+// gravadas individualmente para permitir consulta e remarcação isoladas
+func occurrences(event *domain.Event) ([]*domain.Event, error) {
+	if event.Recurrence == nil {
+		return []*domain.Event{event}, nil
+	}
+
+	recurrence := event.Recurrence
+	seriesID := primitive.NewObjectID()
+	duration := event.EndAt.Sub(event.StartAt)
+
+	events := []*domain.Event{}
+	for i := 0; ; i++ {
+		if recurrence.Count > 0 && i >= recurrence.Count {
+			break
+		}
+
+		step := i * recurrence.Interval
+		var start time.Time
+		switch recurrence.Frequency {
+		case domain.RecurrenceDaily:
+			start = event.StartAt.AddDate(0, 0, step)
+		case domain.RecurrenceWeekly:
+			start = event.StartAt.AddDate(0, 0, 7*step)
+		case domain.RecurrenceMonthly:
+			start = event.StartAt.AddDate(0, step, 0)
+		}
+		if !recurrence.Until.IsZero() && start.After(recurrence.Until) {
+			break
+		}
+		if len(events) == maxRecurrenceOccurrences {
+			return nil, newValidationError("a recorrência gera mais de %d ocorrências", maxRecurrenceOccurrences)
+		}
+
+		occurrence := *event
+		occurrence.ID = primitive.NilObjectID
+		occurrence.StartAt = start
+		occurrence.EndAt = start.Add(duration)
+		occurrence.SeriesID = seriesID
+		events = append(events, &occurrence)
+	}
+
+	return events, nil
+}
+
+// checkOverlaps procura compromissos dos envolvidos nos mesmos horários
+func (s *EventService) checkOverlaps(events []*domain.Event, exclude []primitive.ObjectID) error {
+	overlaps := []*domain.Event{}
+	seen := make(map[primitive.ObjectID]bool)
+	for _, event := range events {
+		if event.Status == domain.EventStatusCancelled {
+			continue
+		}
+		found, err := s.eventRepo.FindOverlapping(event.Users(), event.StartAt, event.EndAt, exclude)
+		if err != nil {
+			return err
+		}
+		for _, other := range found {
+			if !seen[other.ID] {
+				seen[other.ID] = true
+				overlaps = append(overlaps, other)
+			}
+		}
+	}
+
+	if len(overlaps) > 0 {
+		return &EventOverlapError{Overlaps: overlaps}
+	}
+	return nil
+}
+
+// Create agenda o compromisso (ou todas as ocorrências, se recorrente). Sem
+// allowOverlap, sobreposições com a agenda dos envolvidos são recusadas.
+func (s *EventService) Create(event *domain.Event, allowOverlap bool) ([]*domain.Event, error) {
+	event.Status = domain.EventStatusScheduled
+	if err := s.validate(event); err != nil {
+		return nil, err
+	}
+	if event.Recurrence != nil {
+		if err := validateRecurrence(event); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	event.CreatedAt = now
+	event.UpdatedAt = now
+
+	events, err := occurrences(event)
+	if err != nil {
+		return nil, err
+	}
+	if !allowOverlap {
+		if err := s.checkOverlaps(events, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.eventRepo.Create(events...); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (s *EventService) GetByID(id string) (*domain.Event, error) {
+	return s.eventRepo.FindByID(id)
+}
+
+// List consulta a agenda no período. Consultas por processo dispensam o
+// período e retornam todos os compromissos do processo.
+func (s *EventService) List(query domain.EventQuery) ([]*domain.Event, error) {
+	if query.Type != "" && !query.Type.IsValid() {
+		return nil, newValidationError("tipo de compromisso inválido: %s", query.Type)
+	}
+	if query.Status != "" && !query.Status.IsValid() {
+		return nil, newValidationError("status do compromisso inválido: %s", query.Status)
+	}
+
+	if query.CaseID != "" && query.From.IsZero() && query.To.IsZero() {
+		query.To = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+		return s.eventRepo.Find(query)
+	}
+
+	if query.From.IsZero() || query.To.IsZero() {
+		return nil, newValidationError("informe o período da consulta (from e to)")
+	}
+	if !query.To.After(query.From) {
+		return nil, newValidationError("o fim do período deve ser posterior ao início")
+	}
+	if query.To.Sub(query.From) > maxAgendaRangeDays*24*time.Hour {
+		return nil, newValidationError("o período deve ter no máximo %d dias", maxAgendaRangeDays)
+	}
+	return s.eventRepo.Find(query)
+}
+
+// Update altera uma única ocorrência do compromisso
+func (s *EventService) Update(event *domain.Event, allowOverlap bool) error {
+	existing, err := s.eventRepo.FindByID(event.ID.Hex())
+	if err != nil {
+		return err
+	}
+
+	// A regra de recorrência só é aplicada na criação da série
+	event.Recurrence = existing.Recurrence
+	event.SeriesID = existing.SeriesID
+
+	if err := s.validate(event); err != nil {
+		return err
+	}
+	if !allowOverlap {
+		if err := s.checkOverlaps([]*domain.Event{event}, []primitive.ObjectID{event.ID}); err != nil {
+			return err
+		}
+	}
+
+	// Preservar os dados de criação originais
+	event.CreatedAt = existing.CreatedAt
+	event.CreatedBy = existing.CreatedBy
+	event.UpdatedAt = time.Now()
+
+	return s.eventRepo.Update(event)
+}
+
+// Delete remove o compromisso; com wholeSeries, remove também as ocorrências
+// seguintes da série
+func (s *EventService) Delete(id string, wholeSeries bool) (int64, error) {
+	event, err := s.eventRepo.FindByID(id)
+	if err != nil {
+		return 0, err
+	}
+
+	if wholeSeries && !event.SeriesID.IsZero() {
+		return s.eventRepo.DeleteSeries(event.SeriesID.Hex(), event.StartAt)
+	}
+	if err := s.eventRepo.Delete(id); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
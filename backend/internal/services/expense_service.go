@@ -0,0 +1,356 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrExpenseInvoiced é retornado ao alterar ou remover uma despesa já faturada
+	ErrExpenseInvoiced = errors.New("a despesa já foi faturada; cancele a fatura para alterá-la")
+
+	// ErrExpenseApproved é retornado ao alterar uma despesa já aprovada
+	ErrExpenseApproved = errors.New("a despesa já foi aprovada e não pode ser alterada")
+
+	// ErrExpenseReviewed é retornado ao aprovar ou rejeitar uma despesa que não está pendente
+	ErrExpenseReviewed = errors.New("a despesa não está pendente de aprovação")
+
+	// ErrNotExpenseApprover é retornado quando o usuário não pode aprovar a despesa
+	ErrNotExpenseApprover = errors.New("apenas o supervisor de quem lançou a despesa ou um administrador pode analisá-la")
+
+	// ErrNotExpenseAuthor é retornado quando outro usuário tenta alterar a despesa
+	ErrNotExpenseAuthor = errors.New("apenas quem lançou a despesa pode alterá-la")
+)
+
+// maxExpenseDescription limita o tamanho da descrição da despesa
+const maxExpenseDescription = 500
+
+var expenseCategoryLabels = map[domain.ExpenseCategory]string{
+	domain.ExpenseCategoryCourtFees: "Custas processuais",
+	domain.ExpenseCategoryTravel:    "Deslocamento",
+	domain.ExpenseCategoryCopies:    "Cópias",
+	domain.ExpenseCategoryPostage:   "Correios",
+	domain.ExpenseCategoryNotary:    "Cartório",
+	domain.ExpenseCategoryExpert:    "Perícia",
+	domain.ExpenseCategoryOther:     "Despesa",
+}
+
+type ExpenseService struct {
+	expenseRepo     domain.ExpenseRepository
+	caseRepo        domain.CaseRepository
+	userRepo        domain.UserRepository
+	documentService *DocumentService
+}
+
+func NewExpenseService(expenseRepo domain.ExpenseRepository, caseRepo domain.CaseRepository, userRepo domain.UserRepository, documentService *DocumentService) *ExpenseService {
+	return &ExpenseService{
+		expenseRepo:     expenseRepo,
+		caseRepo:        caseRepo,
+		userRepo:        userRepo,
+		documentService: documentService,
+	}
+}
+
+func (s *ExpenseService) validate(expense *domain.Expense) error {
+	if !expense.Category.IsValid() {
+		return newValidationError("categoria de despesa inválida: %s", expense.Category)
+	}
+	expense.Description = strings.TrimSpace(expense.Description)
+	if expense.Description == "" {
+		return newValidationError("descrição da despesa é obrigatória")
+	}
+	if len(expense.Description) > maxExpenseDescription {
+		return newValidationError("descrição deve ter no máximo %d caracteres", maxExpenseDescription)
+	}
+	if expense.AmountCents <= 0 {
+		return newValidationError("o valor da despesa deve ser positivo")
+	}
+	if expense.Date.IsZero() {
+		return newValidationError("data da despesa é obrigatória")
+	}
+	if expense.Date.After(calendar.Date(time.Now())) {
+		return newValidationError("a data da despesa não pode ser futura")
+	}
+
+	if expense.CaseID.IsZero() {
+		return newValidationError("processo é obrigatório")
+	}
+	case_, err := s.caseRepo.FindByID(expense.CaseID.Hex())
+	if err != nil {
+		if errors.Is(err, repositories.ErrCaseNotFound) {
+			return newValidationError("processo não encontrado")
+		}
+		return err
+	}
+	expense.ClientID = case_.ClientID
+
+	return nil
+}
+
+// Create registra a despesa como pendente de aprovação pelo supervisor de
+// quem a lançou; sem supervisor, a aprovação cabe a um administrador
+func (s *ExpenseService) Create(expense *domain.Expense) error {
+	if err := s.validate(expense); err != nil {
+		return err
+	}
+
+	author, err := s.userRepo.FindByID(expense.CreatedBy.Hex())
+	if err != nil {
+		return err
+	}
+
+	approverID, err := s.approverFor(author)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	expense.Status = domain.ExpenseStatusPending
+	expense.ApproverID = approverID
+	expense.ReceiptDocumentID = primitive.NilObjectID
+	expense.ReviewedBy = primitive.NilObjectID
+	expense.ReviewedAt = nil
+	expense.RejectionReason = ""
+	expense.InvoiceID = primitive.NilObjectID
+	expense.CreatedAt = now
+	expense.UpdatedAt = now
+
+	return s.expenseRepo.Create(expense)
+}
+
+// approverFor retorna o supervisor do autor, definido por quem administra
+// usuários. Supervisor removido, desativado ou o próprio autor deixam a
+// aprovação com um administrador (ID vazio).
+func (s *ExpenseService) approverFor(author *domain.User) (primitive.ObjectID, error) {
+	supervisorID := author.ProfessionalInfo.SupervisorID
+	if supervisorID.IsZero() || supervisorID == author.ID {
+		return primitive.NilObjectID, nil
+	}
+
+	supervisor, err := s.userRepo.FindByID(supervisorID.Hex())
+	if errors.Is(err, repositories.ErrUserNotFound) {
+		return primitive.NilObjectID, nil
+	}
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	if !supervisor.IsActive {
+		return primitive.NilObjectID, nil
+	}
+	return supervisor.ID, nil
+}
+
+func (s *ExpenseService) GetByID(id string) (*domain.Expense, error) {
+	return s.expenseRepo.FindByID(id)
+}
+
+func (s *ExpenseService) List(query domain.ExpenseQuery) ([]*domain.Expense, error) {
+	if query.Status != "" && !query.Status.IsValid() {
+		return nil, newValidationError("status de despesa inválido: %s", query.Status)
+	}
+	return s.expenseRepo.Find(query)
+}
+
+// GetPendingApproval lista as despesas que aguardam a análise do usuário.
+// Administradores veem também as despesas de quem não tem supervisor.
+func (s *ExpenseService) GetPendingApproval(approver *domain.User) ([]*domain.Expense, error) {
+	expenses, err := s.expenseRepo.Find(domain.ExpenseQuery{
+		ApproverID: approver.ID.Hex(),
+		Status:     domain.ExpenseStatusPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if approver.Role != domain.RoleAdmin.Name {
+		return expenses, nil
+	}
+
+	pending, err := s.expenseRepo.Find(domain.ExpenseQuery{Status: domain.ExpenseStatusPending})
+	if err != nil {
+		return nil, err
+	}
+	for _, expense := range pending {
+		if expense.ApproverID.IsZero() {
+			expenses = append(expenses, expense)
+		}
+	}
+	return expenses, nil
+}
+
+// Update altera uma despesa pendente ou rejeitada, apenas pelo autor; a
+// despesa rejeitada alterada volta para análise
+func (s *ExpenseService) Update(expense *domain.Expense, editedBy primitive.ObjectID) error {
+	existing, err := s.expenseRepo.FindByID(expense.ID.Hex())
+	if err != nil {
+		return err
+	}
+	if err := checkExpenseEditable(existing, editedBy); err != nil {
+		return err
+	}
+
+	if err := s.validate(expense); err != nil {
+		return err
+	}
+
+	// Preservar os dados de criação originais
+	expense.CreatedBy = existing.CreatedBy
+	expense.CreatedAt = existing.CreatedAt
+	expense.ApproverID = existing.ApproverID
+	expense.ReceiptDocumentID = existing.ReceiptDocumentID
+	expense.InvoiceID = existing.InvoiceID
+	expense.Status = domain.ExpenseStatusPending
+	expense.ReviewedBy = primitive.NilObjectID
+	expense.ReviewedAt = nil
+	expense.RejectionReason = ""
+	expense.UpdatedAt = time.Now()
+
+	return s.expenseRepo.Update(expense)
+}
+
+// checkExpenseEditable garante que só o autor altere a despesa, e apenas
+// enquanto ela não tiver sido aprovada nem faturada
+func checkExpenseEditable(expense *domain.Expense, userID primitive.ObjectID) error {
+	if !expense.InvoiceID.IsZero() {
+		return ErrExpenseInvoiced
+	}
+	if expense.Status == domain.ExpenseStatusApproved {
+		return ErrExpenseApproved
+	}
+	if expense.CreatedBy != userID {
+		return ErrNotExpenseAuthor
+	}
+	return nil
+}
+
+// Delete remove a despesa ainda não faturada. Como em Update, só o autor pode
+// removê-la antes da aprovação; depois disso, apenas um administrador. O
+// comprovante continua entre os documentos do processo.
+func (s *ExpenseService) Delete(id string, deletedBy *domain.User) error {
+	expense, err := s.expenseRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if !expense.InvoiceID.IsZero() {
+		return ErrExpenseInvoiced
+	}
+	if deletedBy.Role != domain.RoleAdmin.Name {
+		if err := checkExpenseEditable(expense, deletedBy.ID); err != nil {
+			return err
+		}
+	}
+	return s.expenseRepo.Delete(id)
+}
+
+// AttachReceipt guarda o comprovante como documento do processo. Um novo
+// envio vira uma nova versão do comprovante já anexado. Como em Update, só o
+// autor pode enviá-lo antes da aprovação, e a despesa rejeitada volta para análise.
+func (s *ExpenseService) AttachReceipt(id string, file domain.FileUpload, uploadedBy primitive.ObjectID) (*domain.Expense, error) {
+	expense, err := s.expenseRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkExpenseEditable(expense, uploadedBy); err != nil {
+		return nil, err
+	}
+
+	if expense.Status == domain.ExpenseStatusRejected {
+		expense.Status = domain.ExpenseStatusPending
+		expense.ReviewedBy = primitive.NilObjectID
+		expense.ReviewedAt = nil
+		expense.RejectionReason = ""
+	}
+
+	replaced := false
+	if !expense.ReceiptDocumentID.IsZero() {
+		_, err := s.documentService.AddVersion(expense.ReceiptDocumentID.Hex(), file, "Comprovante substituído", uploadedBy)
+		if err != nil && !errors.Is(err, repositories.ErrDocumentNotFound) {
+			return nil, err
+		}
+		// Se o documento do comprovante foi removido, anexar um novo
+		replaced = err == nil
+	}
+	if replaced {
+		expense.UpdatedAt = time.Now()
+		if err := s.expenseRepo.Update(expense); err != nil {
+			return nil, err
+		}
+		return expense, nil
+	}
+
+	document := &domain.Document{
+		Title:       "Comprovante de despesa - " + expense.Description,
+		Description: expenseCategoryLabels[expense.Category],
+		CaseID:      expense.CaseID,
+		CreatedBy:   uploadedBy,
+	}
+	if err := s.documentService.Upload(document, file); err != nil {
+		return nil, err
+	}
+
+	expense.ReceiptDocumentID = document.ID
+	expense.UpdatedAt = time.Now()
+	if err := s.expenseRepo.Update(expense); err != nil {
+		return nil, err
+	}
+	return expense, nil
+}
+
+func (s *ExpenseService) review(id string, reviewer *domain.User) (*domain.Expense, error) {
+	expense, err := s.expenseRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if expense.Status != domain.ExpenseStatusPending {
+		return nil, ErrExpenseReviewed
+	}
+
+	isAdmin := reviewer.Role == domain.RoleAdmin.Name
+	if !isAdmin && (expense.ApproverID != reviewer.ID || expense.CreatedBy == reviewer.ID) {
+		return nil, ErrNotExpenseApprover
+	}
+
+	now := time.Now()
+	expense.ReviewedBy = reviewer.ID
+	expense.ReviewedAt = &now
+	expense.UpdatedAt = now
+	return expense, nil
+}
+
+// Approve aprova a despesa; se marcada para cobrança, ela entra na próxima fatura do cliente
+func (s *ExpenseService) Approve(id string, reviewer *domain.User) (*domain.Expense, error) {
+	expense, err := s.review(id, reviewer)
+	if err != nil {
+		return nil, err
+	}
+
+	expense.Status = domain.ExpenseStatusApproved
+	if err := s.expenseRepo.Update(expense); err != nil {
+		return nil, err
+	}
+	return expense, nil
+}
+
+func (s *ExpenseService) Reject(id string, reviewer *domain.User, reason string) (*domain.Expense, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, newValidationError("motivo da rejeição é obrigatório")
+	}
+
+	expense, err := s.review(id, reviewer)
+	if err != nil {
+		return nil, err
+	}
+
+	expense.Status = domain.ExpenseStatusRejected
+	expense.RejectionReason = reason
+	if err := s.expenseRepo.Update(expense); err != nil {
+		return nil, err
+	}
+	return expense, nil
+}
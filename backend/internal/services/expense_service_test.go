@@ -0,0 +1,62 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jurisconnect/backend/internal/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type expenseDeleteStub struct {
+	domain.ExpenseRepository
+	expense *domain.Expense
+	deleted bool
+}
+
+func (r *expenseDeleteStub) FindByID(id string) (*domain.Expense, error) {
+	return r.expense, nil
+}
+
+func (r *expenseDeleteStub) Delete(id string) error {
+	r.deleted = true
+	return nil
+}
+
+func TestExpenseDelete(t *testing.T) {
+	author := &domain.User{ID: primitive.NewObjectID(), Role: domain.RoleLawyer.Name}
+	other := &domain.User{ID: primitive.NewObjectID(), Role: domain.RoleLawyer.Name}
+	admin := &domain.User{ID: primitive.NewObjectID(), Role: domain.RoleAdmin.Name}
+
+	tests := []struct {
+		name    string
+		status  domain.ExpenseStatus
+		invoice bool
+		user    *domain.User
+		want    error
+	}{
+		{"autor antes da aprovação", domain.ExpenseStatusPending, false, author, nil},
+		{"outro usuário", domain.ExpenseStatusPending, false, other, ErrNotExpenseAuthor},
+		{"autor após a aprovação", domain.ExpenseStatusApproved, false, author, ErrExpenseApproved},
+		{"administrador após a aprovação", domain.ExpenseStatusApproved, false, admin, nil},
+		{"administrador com despesa faturada", domain.ExpenseStatusApproved, true, admin, ErrExpenseInvoiced},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expense := &domain.Expense{ID: primitive.NewObjectID(), Status: tt.status, CreatedBy: author.ID}
+			if tt.invoice {
+				expense.InvoiceID = primitive.NewObjectID()
+			}
+			repo := &expenseDeleteStub{expense: expense}
+
+			err := NewExpenseService(repo, nil, nil, nil).Delete(expense.ID.Hex(), tt.user)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Delete = %v, esperado %v", err, tt.want)
+			}
+			if repo.deleted != (tt.want == nil) {
+				t.Errorf("removida = %v", repo.deleted)
+			}
+		})
+	}
+}
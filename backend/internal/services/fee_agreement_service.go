@@ -0,0 +1,146 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+)
+
+// ErrFeeAgreementBilled é retornado ao remover um contrato que já gerou cobranças
+var ErrFeeAgreementBilled = errors.New("o contrato já foi faturado; desative-o em vez de removê-lo")
+
+type FeeAgreementService struct {
+	agreementRepo domain.FeeAgreementRepository
+	clientRepo    domain.ClientRepository
+	caseRepo      domain.CaseRepository
+}
+
+func NewFeeAgreementService(agreementRepo domain.FeeAgreementRepository, clientRepo domain.ClientRepository, caseRepo domain.CaseRepository) *FeeAgreementService {
+	return &FeeAgreementService{agreementRepo: agreementRepo, clientRepo: clientRepo, caseRepo: caseRepo}
+}
+
+func (s *FeeAgreementService) validate(agreement *domain.FeeAgreement) error {
+	if !agreement.Type.IsValid() {
+		return newValidationError("modalidade de honorários inválida: use fixo, hora, exito ou mensalidade")
+	}
+	agreement.Description = strings.TrimSpace(agreement.Description)
+	if len(agreement.Description) > 500 {
+		return newValidationError("descrição deve ter no máximo 500 caracteres")
+	}
+
+	if _, err := s.clientRepo.FindByID(agreement.ClientID.Hex()); err != nil {
+		if errors.Is(err, repositories.ErrClientNotFound) || errors.Is(err, repositories.ErrInvalidID) {
+			return newValidationError("cliente não encontrado")
+		}
+		return err
+	}
+	if !agreement.CaseID.IsZero() {
+		case_, err := s.caseRepo.FindByID(agreement.CaseID.Hex())
+		if err != nil {
+			if errors.Is(err, repositories.ErrCaseNotFound) {
+				return newValidationError("processo não encontrado")
+			}
+			return err
+		}
+		if case_.ClientID != agreement.ClientID {
+			return newValidationError("o processo não pertence ao cliente do contrato")
+		}
+	}
+
+	// Cada modalidade usa apenas os seus próprios valores
+	switch agreement.Type {
+	case domain.FeeTypeFixed, domain.FeeTypeRetainer:
+		if agreement.AmountCents <= 0 {
+			return newValidationError("valor dos honorários deve ser positivo")
+		}
+		agreement.HourlyRateCents = 0
+		agreement.SuccessPercentage = 0
+		agreement.SuccessBaseCents = 0
+	case domain.FeeTypeHourly:
+		if agreement.HourlyRateCents <= 0 {
+			return newValidationError("valor da hora deve ser positivo")
+		}
+		agreement.AmountCents = 0
+		agreement.SuccessPercentage = 0
+		agreement.SuccessBaseCents = 0
+	case domain.FeeTypeSuccess:
+		if agreement.SuccessPercentage <= 0 || agreement.SuccessPercentage > 100 {
+			return newValidationError("percentual de êxito deve estar entre 0 e 100")
+		}
+		if agreement.SuccessBaseCents < 0 {
+			return newValidationError("proveito econômico não pode ser negativo")
+		}
+		agreement.AmountCents = 0
+		agreement.HourlyRateCents = 0
+	}
+
+	if agreement.StartDate.IsZero() {
+		agreement.StartDate = calendar.Date(time.Now())
+	}
+	if agreement.EndDate != nil && agreement.EndDate.Before(agreement.StartDate) {
+		return newValidationError("o fim da vigência deve ser posterior ao início")
+	}
+
+	return nil
+}
+
+func (s *FeeAgreementService) Create(agreement *domain.FeeAgreement) error {
+	if err := s.validate(agreement); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	agreement.IsActive = true
+	agreement.BilledPeriods = []string{}
+	agreement.CreatedAt = now
+	agreement.UpdatedAt = now
+
+	return s.agreementRepo.Create(agreement)
+}
+
+func (s *FeeAgreementService) GetByID(id string) (*domain.FeeAgreement, error) {
+	return s.agreementRepo.FindByID(id)
+}
+
+func (s *FeeAgreementService) GetByClientID(clientID string) ([]*domain.FeeAgreement, error) {
+	return s.agreementRepo.FindByClientID(clientID, false)
+}
+
+// Update altera o contrato; cliente, modalidade e cobranças já feitas não mudam
+func (s *FeeAgreementService) Update(agreement *domain.FeeAgreement) error {
+	existing, err := s.agreementRepo.FindByID(agreement.ID.Hex())
+	if err != nil {
+		return err
+	}
+
+	if agreement.ClientID != existing.ClientID || agreement.Type != existing.Type {
+		return newValidationError("cliente e modalidade do contrato não podem ser alterados")
+	}
+	if err := s.validate(agreement); err != nil {
+		return err
+	}
+
+	// Preservar os dados de criação e de faturamento originais
+	agreement.BilledInvoiceID = existing.BilledInvoiceID
+	agreement.BilledPeriods = existing.BilledPeriods
+	agreement.CreatedAt = existing.CreatedAt
+	agreement.CreatedBy = existing.CreatedBy
+	agreement.UpdatedAt = time.Now()
+
+	return s.agreementRepo.Update(agreement)
+}
+
+func (s *FeeAgreementService) Delete(id string) error {
+	agreement, err := s.agreementRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if !agreement.BilledInvoiceID.IsZero() || len(agreement.BilledPeriods) > 0 {
+		return ErrFeeAgreementBilled
+	}
+	return s.agreementRepo.Delete(id)
+}
@@ -0,0 +1,234 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+)
+
+// courtCodePattern aceita o tribunal ("J.TR", ex.: "8.26") ou o foro ("J.TR.OOOO")
+var courtCodePattern = regexp.MustCompile(`^\d\.\d{2}(\.\d{4})?$`)
+
+// maxCalendarRangeDays limita períodos de suspensão e consultas de intervalo
+const maxCalendarRangeDays = 366
+
+type HolidayService struct {
+	holidayRepo domain.HolidayRepository
+	calendar    *calendar.Calendar
+}
+
+func NewHolidayService(holidayRepo domain.HolidayRepository, cal *calendar.Calendar) *HolidayService {
+	return &HolidayService{holidayRepo: holidayRepo, calendar: cal}
+}
+
+func (s *HolidayService) validate(holiday *domain.Holiday) error {
+	holiday.Name = strings.TrimSpace(holiday.Name)
+	if holiday.Name == "" {
+		return newValidationError("nome do feriado é obrigatório")
+	}
+	if len(holiday.Name) > 200 {
+		return newValidationError("nome do feriado deve ter no máximo 200 caracteres")
+	}
+
+	if holiday.Kind == "" {
+		holiday.Kind = domain.HolidayKindHoliday
+	}
+	if !holiday.Kind.IsValid() {
+		return newValidationError("tipo inválido: use \"feriado\" ou \"suspensao\"")
+	}
+
+	if holiday.Date.IsZero() {
+		return newValidationError("data do feriado é obrigatória")
+	}
+	holiday.Date = calendar.Date(holiday.Date)
+	if holiday.EndDate.IsZero() {
+		holiday.EndDate = holiday.Date
+	}
+	holiday.EndDate = calendar.Date(holiday.EndDate)
+	if holiday.EndDate.Before(holiday.Date) {
+		return newValidationError("data final anterior à data inicial")
+	}
+	if holiday.EndDate.Sub(holiday.Date) > maxCalendarRangeDays*24*time.Hour {
+		return newValidationError("o período deve ter no máximo %d dias", maxCalendarRangeDays)
+	}
+
+	holiday.State = strings.ToUpper(strings.TrimSpace(holiday.State))
+	holiday.Court = strings.TrimSpace(holiday.Court)
+	switch holiday.Scope {
+	case domain.HolidayScopeNational:
+		holiday.State = ""
+		holiday.Court = ""
+	case domain.HolidayScopeState:
+		if len(holiday.State) != 2 {
+			return newValidationError("feriados estaduais exigem a UF com 2 letras")
+		}
+		holiday.Court = ""
+	case domain.HolidayScopeCourt:
+		if !courtCodePattern.MatchString(holiday.Court) {
+			return newValidationError("código do órgão inválido: use \"J.TR\" (ex.: 8.26) ou \"J.TR.OOOO\" (ex.: 8.26.0100)")
+		}
+		if holiday.State != "" && len(holiday.State) != 2 {
+			return newValidationError("UF deve ter 2 letras")
+		}
+	default:
+		return newValidationError("abrangência inválida: use \"national\", \"state\" ou \"court\"")
+	}
+
+	return nil
+}
+
+func (s *HolidayService) Create(holiday *domain.Holiday) error {
+	if err := s.validate(holiday); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	holiday.CreatedAt = now
+	holiday.UpdatedAt = now
+
+	return s.holidayRepo.Create(holiday)
+}
+
+func (s *HolidayService) GetByID(id string) (*domain.Holiday, error) {
+	return s.holidayRepo.FindByID(id)
+}
+
+func (s *HolidayService) List(query domain.HolidayQuery) ([]*domain.Holiday, error) {
+	if query.Scope != "" && !query.Scope.IsValid() {
+		return nil, newValidationError("abrangência inválida: %s", query.Scope)
+	}
+	query.State = strings.ToUpper(strings.TrimSpace(query.State))
+	query.Court = strings.TrimSpace(query.Court)
+	return s.holidayRepo.Find(query)
+}
+
+func (s *HolidayService) Update(holiday *domain.Holiday) error {
+	existing, err := s.holidayRepo.FindByID(holiday.ID.Hex())
+	if err != nil {
+		return err
+	}
+
+	if err := s.validate(holiday); err != nil {
+		return err
+	}
+
+	// Preservar os dados de criação originais
+	holiday.CreatedAt = existing.CreatedAt
+	holiday.CreatedBy = existing.CreatedBy
+	holiday.UpdatedAt = time.Now()
+
+	return s.holidayRepo.Update(holiday)
+}
+
+func (s *HolidayService) Delete(id string) error {
+	return s.holidayRepo.Delete(id)
+}
+
+// Import cadastra os feriados de um arquivo iCalendar ou CSV. Campos ausentes
+// no arquivo (abrangência, UF, órgão, tipo) são preenchidos com defaults;
+// feriados já cadastrados são ignorados e itens inválidos são relatados sem
+// interromper a importação.
+func (s *HolidayService) Import(format string, content io.Reader, defaults domain.Holiday) (*domain.HolidayImportResult, error) {
+	var holidays []domain.Holiday
+	var err error
+	switch strings.ToLower(format) {
+	case calendar.FormatICS:
+		holidays, err = calendar.ParseICS(content)
+	case calendar.FormatCSV:
+		holidays, err = calendar.ParseCSV(content)
+	default:
+		return nil, newValidationError("formato de importação não suportado: use ics ou csv")
+	}
+	if err != nil {
+		return nil, newValidationError("arquivo inválido: %v", err)
+	}
+
+	result := &domain.HolidayImportResult{Errors: []string{}}
+	for i := range holidays {
+		holiday := &holidays[i]
+		if holiday.Scope == "" {
+			holiday.Scope = defaults.Scope
+		}
+		if holiday.State == "" {
+			holiday.State = defaults.State
+		}
+		if holiday.Court == "" {
+			holiday.Court = defaults.Court
+		}
+		if holiday.Kind == "" {
+			holiday.Kind = defaults.Kind
+		}
+		holiday.CreatedBy = defaults.CreatedBy
+
+		if err := s.Create(holiday); err != nil {
+			switch {
+			case errors.Is(err, repositories.ErrDuplicateHoliday):
+				result.Skipped++
+			case IsValidationError(err):
+				result.Errors = append(result.Errors, fmt.Sprintf("item %d (%s): %v", i+1, holiday.Name, err))
+			default:
+				return nil, err
+			}
+			continue
+		}
+		result.Created++
+	}
+
+	return result, nil
+}
+
+// WorkingDay informa se a data é dia útil para a UF e o órgão informados
+func (s *HolidayService) WorkingDay(date time.Time, state, court string) (*domain.WorkingDayInfo, error) {
+	lookup := s.calendar.For(strings.ToUpper(strings.TrimSpace(state)), strings.TrimSpace(court))
+
+	date = calendar.Date(date)
+	reason, err := lookup.Reason(date)
+	if err != nil {
+		return nil, err
+	}
+	next, _, err := lookup.NextWorkingDay(date)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.WorkingDayInfo{
+		Date:           date,
+		State:          strings.ToUpper(strings.TrimSpace(state)),
+		Court:          strings.TrimSpace(court),
+		WorkingDay:     reason == "",
+		Reason:         reason,
+		NextWorkingDay: next,
+	}, nil
+}
+
+// NonWorkingDays lista os dias não úteis do período para o juízo
+func (s *HolidayService) NonWorkingDays(from, to time.Time, state, court string) ([]domain.NonWorkingDay, error) {
+	from, to = calendar.Date(from), calendar.Date(to)
+	if to.Before(from) {
+		return nil, newValidationError("data final anterior à data inicial")
+	}
+	if to.Sub(from) > maxCalendarRangeDays*24*time.Hour {
+		return nil, newValidationError("o período deve ter no máximo %d dias", maxCalendarRangeDays)
+	}
+
+	lookup := s.calendar.For(strings.ToUpper(strings.TrimSpace(state)), strings.TrimSpace(court))
+	days := []domain.NonWorkingDay{}
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		reason, err := lookup.Reason(day)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			days = append(days, domain.NonWorkingDay{Date: day, Reason: reason})
+		}
+	}
+
+	return days, nil
+}
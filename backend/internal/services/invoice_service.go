@@ -0,0 +1,656 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrInvalidInvoiceTransition é retornado quando a mudança de status da fatura não é permitida
+	ErrInvalidInvoiceTransition = errors.New("transição de status da fatura não permitida")
+
+	// ErrNothingToInvoice é retornado quando não há itens pendentes de faturamento
+	ErrNothingToInvoice = errors.New("não há horas, despesas ou honorários pendentes de faturamento")
+
+	// ErrConcurrentInvoice é retornado quando outra fatura levou itens desta durante a geração
+	ErrConcurrentInvoice = errors.New("outra fatura do cliente foi gerada ao mesmo tempo; tente novamente")
+)
+
+// defaultInvoiceDueDays é o prazo de vencimento padrão das faturas
+const defaultInvoiceDueDays = 15
+
+type InvoiceService struct {
+	invoiceRepo   domain.InvoiceRepository
+	agreementRepo domain.FeeAgreementRepository
+	entryRepo     domain.TimeEntryRepository
+	expenseRepo   domain.ExpenseRepository
+	clientRepo    domain.ClientRepository
+	caseRepo      domain.CaseRepository
+}
+
+func NewInvoiceService(invoiceRepo domain.InvoiceRepository, agreementRepo domain.FeeAgreementRepository, entryRepo domain.TimeEntryRepository, expenseRepo domain.ExpenseRepository, clientRepo domain.ClientRepository, caseRepo domain.CaseRepository) *InvoiceService {
+	return &InvoiceService{
+		invoiceRepo:   invoiceRepo,
+		agreementRepo: agreementRepo,
+		entryRepo:     entryRepo,
+		expenseRepo:   expenseRepo,
+		clientRepo:    clientRepo,
+		caseRepo:      caseRepo,
+	}
+}
+
+// Generate monta uma fatura em rascunho com as horas faturáveis e as despesas
+// aprovadas ainda não cobradas e os honorários devidos pelos contratos do
+// cliente até o fim do período
+func (s *InvoiceService) Generate(params domain.InvoiceGeneration) (*domain.Invoice, error) {
+	if _, err := s.clientRepo.FindByID(params.ClientID.Hex()); err != nil {
+		if errors.Is(err, repositories.ErrClientNotFound) || errors.Is(err, repositories.ErrInvalidID) {
+			return nil, newValidationError("cliente não encontrado")
+		}
+		return nil, err
+	}
+	if !params.CaseID.IsZero() {
+		case_, err := s.caseRepo.FindByID(params.CaseID.Hex())
+		if err != nil {
+			if errors.Is(err, repositories.ErrCaseNotFound) {
+				return nil, newValidationError("processo não encontrado")
+			}
+			return nil, err
+		}
+		if case_.ClientID != params.ClientID {
+			return nil, newValidationError("o processo não pertence ao cliente")
+		}
+	}
+
+	today := calendar.Date(time.Now())
+	if params.PeriodEnd.IsZero() {
+		params.PeriodEnd = today
+	}
+	if params.PeriodStart != nil && params.PeriodStart.After(params.PeriodEnd) {
+		return nil, newValidationError("o início do período deve ser anterior ao fim")
+	}
+	if params.DueDate.IsZero() {
+		params.DueDate = today.AddDate(0, 0, defaultInvoiceDueDays)
+	}
+	if params.DueDate.Before(today) {
+		return nil, newValidationError("o vencimento não pode ser anterior a hoje")
+	}
+
+	invoiceID := primitive.NewObjectID()
+
+	timeItems, entryIDs, err := s.timeItems(params)
+	if err != nil {
+		return nil, err
+	}
+	expenseItems, expenseIDs, err := s.expenseItems(params)
+	if err != nil {
+		return nil, err
+	}
+	feeItems, agreements, err := s.feeItems(params, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := slices.Concat(timeItems, expenseItems, feeItems)
+	if len(items) == 0 {
+		return nil, ErrNothingToInvoice
+	}
+
+	now := time.Now()
+	invoice := &domain.Invoice{
+		ID:          invoiceID,
+		ClientID:    params.ClientID,
+		CaseID:      params.CaseID,
+		Status:      domain.InvoiceStatusDraft,
+		Items:       items,
+		PeriodStart: params.PeriodStart,
+		PeriodEnd:   params.PeriodEnd,
+		DueDate:     params.DueDate,
+		Notes:       strings.TrimSpace(params.Notes),
+		CreatedBy:   params.CreatedBy,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	for _, item := range items {
+		invoice.TotalCents += item.AmountCents
+	}
+
+	// Vincular as origens antes de gravar a fatura, para que uma geração
+	// simultânea para o mesmo cliente não cobre os mesmos itens duas vezes
+	if err := s.claimSources(invoice, entryIDs, expenseIDs, agreements); err != nil {
+		return nil, err
+	}
+	if err := s.invoiceRepo.Create(invoice); err != nil {
+		if releaseErr := s.release(invoice); releaseErr != nil {
+			log.Printf("Aviso: falha ao liberar os itens da fatura não criada: %v", releaseErr)
+		}
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+// claimSources vincula lançamentos, despesas e contratos à fatura apenas se
+// continuarem livres. Se outra fatura tiver levado algum deles, os vínculos
+// já feitos são desfeitos e a geração falha com ErrConcurrentInvoice.
+func (s *InvoiceService) claimSources(invoice *domain.Invoice, entryIDs, expenseIDs []primitive.ObjectID, agreements []*domain.FeeAgreement) error {
+	claimed := make(map[primitive.ObjectID]bool)
+	err := s.entryRepo.SetInvoice(entryIDs, invoice.ID)
+	if err == nil {
+		err = s.expenseRepo.SetInvoice(expenseIDs, invoice.ID)
+	}
+	if err == nil {
+		for _, agreement := range agreements {
+			previousUpdatedAt := agreement.UpdatedAt
+			agreement.UpdatedAt = invoice.CreatedAt
+			if err = s.agreementRepo.UpdateIfUnchanged(agreement, previousUpdatedAt); err != nil {
+				break
+			}
+			claimed[agreement.ID] = true
+		}
+	}
+	if err == nil {
+		return nil
+	}
+
+	// Desfazer apenas os contratos gravados por esta geração
+	partial := &domain.Invoice{ID: invoice.ID}
+	for _, item := range invoice.Items {
+		if item.Kind == domain.InvoiceItemTime || item.Kind == domain.InvoiceItemExpense || claimed[item.SourceID] {
+			partial.Items = append(partial.Items, item)
+		}
+	}
+	if releaseErr := s.release(partial); releaseErr != nil {
+		log.Printf("Aviso: falha ao liberar os itens da fatura não criada: %v", releaseErr)
+	}
+
+	if errors.Is(err, repositories.ErrAlreadyInvoiced) || errors.Is(err, repositories.ErrFeeAgreementConflict) {
+		return ErrConcurrentInvoice
+	}
+	return err
+}
+
+func (s *InvoiceService) timeItems(params domain.InvoiceGeneration) ([]domain.InvoiceItem, []primitive.ObjectID, error) {
+	query := domain.TimeEntryQuery{
+		ClientID: params.ClientID.Hex(),
+		To:       params.PeriodEnd.AddDate(0, 0, 1),
+		Unbilled: true,
+	}
+	if !params.CaseID.IsZero() {
+		query.CaseID = params.CaseID.Hex()
+	}
+	if params.PeriodStart != nil {
+		query.From = *params.PeriodStart
+	}
+
+	entries, err := s.entryRepo.Find(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items := []domain.InvoiceItem{}
+	ids := []primitive.ObjectID{}
+	for _, entry := range entries {
+		description := string(entry.ActivityCode)
+		if entry.Description != "" {
+			description += " - " + entry.Description
+		}
+		items = append(items, domain.InvoiceItem{
+			Kind:        domain.InvoiceItemTime,
+			Description: description,
+			SourceID:    entry.ID,
+			CaseID:      entry.CaseID,
+			Date:        entry.Date,
+			Minutes:     entry.Minutes,
+			UnitCents:   entry.RateCents,
+			AmountCents: entry.AmountCents,
+		})
+		ids = append(ids, entry.ID)
+	}
+	return items, ids, nil
+}
+
+func (s *InvoiceService) expenseItems(params domain.InvoiceGeneration) ([]domain.InvoiceItem, []primitive.ObjectID, error) {
+	query := domain.ExpenseQuery{
+		ClientID: params.ClientID.Hex(),
+		To:       params.PeriodEnd.AddDate(0, 0, 1),
+		Unbilled: true,
+	}
+	if !params.CaseID.IsZero() {
+		query.CaseID = params.CaseID.Hex()
+	}
+	if params.PeriodStart != nil {
+		query.From = *params.PeriodStart
+	}
+
+	expenses, err := s.expenseRepo.Find(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items := []domain.InvoiceItem{}
+	ids := []primitive.ObjectID{}
+	for _, expense := range expenses {
+		items = append(items, domain.InvoiceItem{
+			Kind:        domain.InvoiceItemExpense,
+			Description: expenseCategoryLabels[expense.Category] + " - " + expense.Description,
+			SourceID:    expense.ID,
+			CaseID:      expense.CaseID,
+			Date:        expense.Date,
+			AmountCents: expense.AmountCents,
+		})
+		ids = append(ids, expense.ID)
+	}
+	return items, ids, nil
+}
+
+// feeItems calcula os honorários devidos e retorna os contratos já marcados
+// como cobrados por esta fatura, para gravação por claimSources
+func (s *InvoiceService) feeItems(params domain.InvoiceGeneration, invoiceID primitive.ObjectID) ([]domain.InvoiceItem, []*domain.FeeAgreement, error) {
+	agreements, err := s.agreementRepo.FindByClientID(params.ClientID.Hex(), true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items := []domain.InvoiceItem{}
+	billed := []*domain.FeeAgreement{}
+	for _, agreement := range agreements {
+		// Faturas de um processo só incluem contratos daquele processo
+		if !params.CaseID.IsZero() && agreement.CaseID != params.CaseID {
+			continue
+		}
+		if agreement.StartDate.After(params.PeriodEnd) {
+			continue
+		}
+
+		description := agreement.Description
+		item := domain.InvoiceItem{SourceID: agreement.ID, CaseID: agreement.CaseID, Date: params.PeriodEnd}
+
+		switch agreement.Type {
+		case domain.FeeTypeFixed:
+			if !agreement.BilledInvoiceID.IsZero() {
+				continue
+			}
+			item.Kind = domain.InvoiceItemFixedFee
+			item.Description = withDefault(description, "Honorários contratuais")
+			item.AmountCents = agreement.AmountCents
+			items = append(items, item)
+			agreement.BilledInvoiceID = invoiceID
+
+		case domain.FeeTypeSuccess:
+			if !agreement.BilledInvoiceID.IsZero() || agreement.SuccessBaseCents == 0 {
+				continue
+			}
+			item.Kind = domain.InvoiceItemSuccessFee
+			item.Description = fmt.Sprintf("%s (%.2f%% sobre o proveito econômico)", withDefault(description, "Honorários de êxito"), agreement.SuccessPercentage)
+			item.UnitCents = agreement.SuccessBaseCents
+			item.AmountCents = int64(math.Round(float64(agreement.SuccessBaseCents) * agreement.SuccessPercentage / 100))
+			items = append(items, item)
+			agreement.BilledInvoiceID = invoiceID
+
+		case domain.FeeTypeRetainer:
+			periods := retainerPeriods(agreement, params.PeriodStart, params.PeriodEnd)
+			if len(periods) == 0 {
+				continue
+			}
+			for _, period := range periods {
+				periodItem := item
+				periodItem.Kind = domain.InvoiceItemRetainer
+				periodItem.Description = fmt.Sprintf("%s - competência %s", withDefault(description, "Honorários mensais"), period)
+				periodItem.Period = period
+				periodItem.AmountCents = agreement.AmountCents
+				items = append(items, periodItem)
+			}
+			agreement.BilledPeriods = append(agreement.BilledPeriods, periods...)
+
+		default:
+			// Contratos por hora são cobrados pelos lançamentos de horas
+			continue
+		}
+
+		billed = append(billed, agreement)
+	}
+
+	return items, billed, nil
+}
+
+// retainerPeriods lista as competências da mensalidade dentro da vigência
+// e do período da fatura que ainda não foram cobradas
+func retainerPeriods(agreement *domain.FeeAgreement, periodStart *time.Time, periodEnd time.Time) []string {
+	first := time.Date(agreement.StartDate.Year(), agreement.StartDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if periodStart != nil {
+		start := time.Date(periodStart.Year(), periodStart.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if start.After(first) {
+			first = start
+		}
+	}
+	last := periodEnd
+	if agreement.EndDate != nil && agreement.EndDate.Before(last) {
+		last = *agreement.EndDate
+	}
+
+	periods := []string{}
+	for month := first; !month.After(last); month = month.AddDate(0, 1, 0) {
+		period := month.Format(domain.PeriodLayout)
+		if !slices.Contains(agreement.BilledPeriods, period) {
+			periods = append(periods, period)
+		}
+	}
+	return periods
+}
+
+func withDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+// MarkOverdue marca como vencidas as faturas emitidas com vencimento passado
+func (s *InvoiceService) MarkOverdue() error {
+	return s.invoiceRepo.MarkOverdue(calendar.Date(time.Now()))
+}
+
+// RunOverdue executa MarkOverdue a cada intervalo, até o contexto ser encerrado
+func (s *InvoiceService) RunOverdue(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.MarkOverdue(); err != nil {
+			log.Printf("Aviso: falha ao marcar as faturas vencidas: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *InvoiceService) GetByID(id string) (*domain.Invoice, error) {
+	return s.invoiceRepo.FindByID(id)
+}
+
+func (s *InvoiceService) List(query domain.InvoiceQuery) ([]*domain.Invoice, error) {
+	if query.Status != "" && !query.Status.IsValid() {
+		return nil, newValidationError("status da fatura inválido: %s", query.Status)
+	}
+	return s.invoiceRepo.Find(query)
+}
+
+// Update altera vencimento e observações; apenas rascunhos podem ser alterados
+func (s *InvoiceService) Update(invoice *domain.Invoice) error {
+	existing, err := s.invoiceRepo.FindByID(invoice.ID.Hex())
+	if err != nil {
+		return err
+	}
+	if existing.Status != domain.InvoiceStatusDraft {
+		return fmt.Errorf("%w: apenas rascunhos podem ser alterados", ErrInvalidInvoiceTransition)
+	}
+	if invoice.DueDate.Before(calendar.Date(time.Now())) {
+		return newValidationError("o vencimento não pode ser anterior a hoje")
+	}
+
+	existing.DueDate = invoice.DueDate
+	existing.Notes = strings.TrimSpace(invoice.Notes)
+	existing.UpdatedAt = time.Now()
+	if err := s.invoiceRepo.Update(existing); err != nil {
+		return err
+	}
+
+	*invoice = *existing
+	return nil
+}
+
+// Issue emite o rascunho, atribuindo o número sequencial da fatura e o txid
+// da cobrança Pix
+func (s *InvoiceService) Issue(id string) (*domain.Invoice, error) {
+	invoice, err := s.invoiceRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.Status != domain.InvoiceStatusDraft {
+		return nil, fmt.Errorf("%w: apenas rascunhos podem ser emitidos", ErrInvalidInvoiceTransition)
+	}
+
+	today := calendar.Date(time.Now())
+	if invoice.DueDate.Before(today) {
+		return nil, newValidationError("o vencimento da fatura já passou; altere-o antes de emitir")
+	}
+
+	number, err := s.invoiceRepo.NextNumber(today.Year())
+	if err != nil {
+		return nil, err
+	}
+
+	invoice.Number = number
+	invoice.PixTxID = invoiceTxID(number)
+	invoice.Status = domain.InvoiceStatusIssued
+	invoice.IssueDate = &today
+	invoice.UpdatedAt = time.Now()
+
+	if err := s.invoiceRepo.Update(invoice); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// MarkPaid registra a quitação de uma fatura emitida ou vencida. Sem valor
+// informado, considera o total da fatura.
+func (s *InvoiceService) MarkPaid(id string, paidAt time.Time, amountCents int64) (*domain.Invoice, error) {
+	invoice, err := s.invoiceRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if !invoice.Status.IsReceivable() {
+		return nil, fmt.Errorf("%w: apenas faturas emitidas ou vencidas podem ser pagas", ErrInvalidInvoiceTransition)
+	}
+
+	if amountCents == 0 {
+		amountCents = invoice.TotalCents
+	}
+	if amountCents < invoice.TotalCents {
+		return nil, newValidationError("valor pago inferior ao total da fatura")
+	}
+	if paidAt.IsZero() {
+		paidAt = time.Now()
+	}
+	if paidAt.After(time.Now()) {
+		return nil, newValidationError("a data de pagamento não pode ser futura")
+	}
+
+	invoice.Status = domain.InvoiceStatusPaid
+	invoice.PaidAt = &paidAt
+	invoice.PaidAmountCents = amountCents
+	invoice.UpdatedAt = time.Now()
+
+	if err := s.invoiceRepo.Update(invoice); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// Cancel cancela a fatura não paga; horas, despesas e honorários voltam a ser faturáveis
+func (s *InvoiceService) Cancel(id, reason string) (*domain.Invoice, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, newValidationError("motivo do cancelamento é obrigatório")
+	}
+
+	invoice, err := s.invoiceRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.Status != domain.InvoiceStatusDraft && !invoice.Status.IsReceivable() {
+		return nil, fmt.Errorf("%w: faturas pagas ou canceladas não podem ser canceladas", ErrInvalidInvoiceTransition)
+	}
+
+	if err := s.release(invoice); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	invoice.Status = domain.InvoiceStatusCancelled
+	invoice.CancelledAt = &now
+	invoice.CancelReason = reason
+	invoice.UpdatedAt = now
+
+	if err := s.invoiceRepo.Update(invoice); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// Delete remove um rascunho, liberando os itens para uma nova fatura
+func (s *InvoiceService) Delete(id string) error {
+	invoice, err := s.invoiceRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if invoice.Status != domain.InvoiceStatusDraft {
+		return fmt.Errorf("%w: apenas rascunhos podem ser removidos; cancele a fatura", ErrInvalidInvoiceTransition)
+	}
+
+	if err := s.release(invoice); err != nil {
+		return err
+	}
+	return s.invoiceRepo.Delete(id)
+}
+
+// release desfaz os vínculos das origens com a fatura
+func (s *InvoiceService) release(invoice *domain.Invoice) error {
+	if err := s.entryRepo.ReleaseInvoice(invoice.ID); err != nil {
+		return err
+	}
+	if err := s.expenseRepo.ReleaseInvoice(invoice.ID); err != nil {
+		return err
+	}
+
+	// Competências cobradas por contrato; contratos de honorários fixos ou de
+	// êxito entram sem competência, apenas para desfazer o vínculo
+	periods := make(map[primitive.ObjectID][]string)
+	var order []primitive.ObjectID
+	for _, item := range invoice.Items {
+		switch item.Kind {
+		case domain.InvoiceItemFixedFee, domain.InvoiceItemSuccessFee, domain.InvoiceItemRetainer:
+		default:
+			continue
+		}
+
+		if _, ok := periods[item.SourceID]; !ok {
+			periods[item.SourceID] = []string{}
+			order = append(order, item.SourceID)
+		}
+		if item.Kind == domain.InvoiceItemRetainer {
+			periods[item.SourceID] = append(periods[item.SourceID], item.Period)
+		}
+	}
+
+	for _, agreementID := range order {
+		err := s.agreementRepo.ReleaseInvoice(agreementID, invoice.ID, periods[agreementID])
+		if err != nil && !errors.Is(err, repositories.ErrFeeAgreementNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Statement monta o extrato do cliente entre from e to (inclusive): faturas
+// emitidas entram como débito e pagamentos como crédito
+func (s *InvoiceService) Statement(clientID string, from, to time.Time) (*domain.ClientStatement, error) {
+	if to.Before(from) {
+		return nil, newValidationError("o fim do período deve ser posterior ao início")
+	}
+	if _, err := s.clientRepo.FindByID(clientID); err != nil {
+		return nil, err
+	}
+
+	end := to.AddDate(0, 0, 1)
+	invoices, err := s.invoiceRepo.FindByClientIssuedBefore(clientID, end)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &domain.ClientStatement{From: from, To: to, Entries: []domain.StatementEntry{}}
+	statement.ClientID, _ = primitive.ObjectIDFromHex(clientID)
+
+	movements := []domain.StatementEntry{}
+	for _, invoice := range invoices {
+		if invoice.Status == domain.InvoiceStatusOverdue {
+			statement.OverdueCents += invoice.TotalCents
+		}
+		movements = append(movements, domain.StatementEntry{
+			Date:        *invoice.IssueDate,
+			InvoiceID:   invoice.ID,
+			Number:      invoice.Number,
+			Description: "Fatura " + invoice.Number,
+			DebitCents:  invoice.TotalCents,
+		})
+		if invoice.PaidAt != nil && invoice.PaidAt.Before(end) {
+			movements = append(movements, domain.StatementEntry{
+				Date:        *invoice.PaidAt,
+				InvoiceID:   invoice.ID,
+				Number:      invoice.Number,
+				Description: "Pagamento da fatura " + invoice.Number,
+				CreditCents: invoice.PaidAmountCents,
+			})
+		}
+	}
+	slices.SortStableFunc(movements, func(a, b domain.StatementEntry) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	balance := int64(0)
+	for _, movement := range movements {
+		balance += movement.DebitCents - movement.CreditCents
+		if movement.Date.Before(from) {
+			statement.OpeningBalanceCents = balance
+			continue
+		}
+		movement.BalanceCents = balance
+		statement.Entries = append(statement.Entries, movement)
+	}
+	statement.ClosingBalanceCents = balance
+
+	return statement, nil
+}
+
+// MonthlySummary totaliza, por competência do ano, o valor faturado (pela
+// data de emissão) e o valor recebido (pela data de pagamento)
+func (s *InvoiceService) MonthlySummary(year int) ([]domain.MonthlyBilling, error) {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+
+	invoices, err := s.invoiceRepo.FindIssuedOrPaidBetween(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	months := make([]domain.MonthlyBilling, 12)
+	for i := range months {
+		months[i].Period = start.AddDate(0, i, 0).Format(domain.PeriodLayout)
+	}
+	for _, invoice := range invoices {
+		if invoice.IssueDate != nil && invoice.IssueDate.Year() == year {
+			months[invoice.IssueDate.Month()-1].IssuedCents += invoice.TotalCents
+		}
+		if invoice.PaidAt != nil && invoice.PaidAt.Year() == year {
+			months[invoice.PaidAt.Month()-1].ReceivedCents += invoice.PaidAmountCents
+		}
+	}
+
+	return months, nil
+}
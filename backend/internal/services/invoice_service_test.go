@@ -0,0 +1,232 @@
+package services
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type invoiceClientStub struct {
+	domain.ClientRepository
+}
+
+func (invoiceClientStub) FindByID(id string) (*domain.Client, error) {
+	return &domain.Client{}, nil
+}
+
+type invoiceRepoStub struct {
+	domain.InvoiceRepository
+	created []*domain.Invoice
+}
+
+func (r *invoiceRepoStub) Create(invoice *domain.Invoice) error {
+	r.created = append(r.created, invoice)
+	return nil
+}
+
+type entryRepoStub struct {
+	domain.TimeEntryRepository
+	entries  []*domain.TimeEntry
+	claimErr error
+	released int
+}
+
+func (r *entryRepoStub) Find(query domain.TimeEntryQuery) ([]*domain.TimeEntry, error) {
+	return r.entries, nil
+}
+
+func (r *entryRepoStub) SetInvoice(ids []primitive.ObjectID, invoiceID primitive.ObjectID) error {
+	return r.claimErr
+}
+
+func (r *entryRepoStub) ReleaseInvoice(invoiceID primitive.ObjectID) error {
+	r.released++
+	return nil
+}
+
+type expenseRepoStub struct {
+	domain.ExpenseRepository
+	expenses []*domain.Expense
+	released int
+}
+
+func (r *expenseRepoStub) Find(query domain.ExpenseQuery) ([]*domain.Expense, error) {
+	return r.expenses, nil
+}
+
+func (r *expenseRepoStub) SetInvoice(ids []primitive.ObjectID, invoiceID primitive.ObjectID) error {
+	return nil
+}
+
+func (r *expenseRepoStub) ReleaseInvoice(invoiceID primitive.ObjectID) error {
+	r.released++
+	return nil
+}
+
+type agreementRepoStub struct {
+	domain.FeeAgreementRepository
+	agreements []*domain.FeeAgreement
+	saved      []*domain.FeeAgreement
+	// conflictID simula outra fatura alterando o contrato durante a geração
+	conflictID primitive.ObjectID
+	released   map[primitive.ObjectID][]string
+}
+
+func (r *agreementRepoStub) FindByClientID(clientID string, activeOnly bool) ([]*domain.FeeAgreement, error) {
+	return r.agreements, nil
+}
+
+func (r *agreementRepoStub) UpdateIfUnchanged(agreement *domain.FeeAgreement, previousUpdatedAt time.Time) error {
+	if agreement.ID == r.conflictID {
+		return repositories.ErrFeeAgreementConflict
+	}
+	r.saved = append(r.saved, agreement)
+	return nil
+}
+
+func (r *agreementRepoStub) ReleaseInvoice(id primitive.ObjectID, invoiceID primitive.ObjectID, periods []string) error {
+	if r.released == nil {
+		r.released = make(map[primitive.ObjectID][]string)
+	}
+	r.released[id] = periods
+	return nil
+}
+
+func TestRetainerPeriods(t *testing.T) {
+	endDate := mustDate(t, "2026-02-10")
+	periodStart := mustDate(t, "2025-12-01")
+
+	tests := []struct {
+		name        string
+		agreement   domain.FeeAgreement
+		periodStart *time.Time
+		periodEnd   string
+		want        []string
+	}{
+		{"desde o início da vigência", domain.FeeAgreement{StartDate: mustDate(t, "2025-11-20")}, nil, "2026-01-31", []string{"2025-11", "2025-12", "2026-01"}},
+		{"a partir do período da fatura", domain.FeeAgreement{StartDate: mustDate(t, "2025-06-01")}, &periodStart, "2026-01-15", []string{"2025-12", "2026-01"}},
+		{"competências já cobradas", domain.FeeAgreement{StartDate: mustDate(t, "2025-11-01"), BilledPeriods: []string{"2025-11", "2025-12"}}, nil, "2026-01-31", []string{"2026-01"}},
+		{"fim da vigência", domain.FeeAgreement{StartDate: mustDate(t, "2025-12-01"), EndDate: &endDate}, nil, "2026-06-30", []string{"2025-12", "2026-01", "2026-02"}},
+		{"vigência posterior", domain.FeeAgreement{StartDate: mustDate(t, "2026-03-01")}, nil, "2026-01-31", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := retainerPeriods(&tt.agreement, tt.periodStart, mustDate(t, tt.periodEnd))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("retainerPeriods = %v, esperado %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func newInvoiceServiceStub(entries *entryRepoStub, expenses *expenseRepoStub, agreements *agreementRepoStub, invoices *invoiceRepoStub) *InvoiceService {
+	return NewInvoiceService(invoices, agreements, entries, expenses, invoiceClientStub{}, nil)
+}
+
+func TestGenerateTotals(t *testing.T) {
+	entries := &entryRepoStub{entries: []*domain.TimeEntry{
+		{ID: primitive.NewObjectID(), ActivityCode: "A101", Minutes: 90, RateCents: 30000, AmountCents: 45000},
+		{ID: primitive.NewObjectID(), ActivityCode: "A102", Minutes: 20, RateCents: 45000, AmountCents: 15000},
+	}}
+	expenses := &expenseRepoStub{expenses: []*domain.Expense{
+		{ID: primitive.NewObjectID(), Category: domain.ExpenseCategoryCourtFees, Description: "Preparo", AmountCents: 1234},
+	}}
+	fixed := &domain.FeeAgreement{ID: primitive.NewObjectID(), Type: domain.FeeTypeFixed, AmountCents: 500000, StartDate: mustDate(t, "2026-01-01")}
+	success := &domain.FeeAgreement{ID: primitive.NewObjectID(), Type: domain.FeeTypeSuccess, SuccessPercentage: 12.5, SuccessBaseCents: 8000001, StartDate: mustDate(t, "2026-01-01")}
+	retainer := &domain.FeeAgreement{ID: primitive.NewObjectID(), Type: domain.FeeTypeRetainer, AmountCents: 200000, StartDate: mustDate(t, "2026-01-10")}
+	hourly := &domain.FeeAgreement{ID: primitive.NewObjectID(), Type: domain.FeeTypeHourly, HourlyRateCents: 30000, StartDate: mustDate(t, "2026-01-01")}
+	agreements := &agreementRepoStub{agreements: []*domain.FeeAgreement{fixed, success, retainer, hourly}}
+	invoices := &invoiceRepoStub{}
+
+	invoice, err := newInvoiceServiceStub(entries, expenses, agreements, invoices).Generate(domain.InvoiceGeneration{
+		ClientID:  primitive.NewObjectID(),
+		PeriodEnd: mustDate(t, "2026-03-15"),
+	})
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	// Horas 45000 + 15000, despesa 1234, fixo 500000, êxito 12,5% de
+	// 8000001 (1000000,125 arredondado) e três mensalidades de 200000
+	const want = 45000 + 15000 + 1234 + 500000 + 1000000 + 3*200000
+	if invoice.TotalCents != want {
+		t.Errorf("TotalCents = %d, esperado %d", invoice.TotalCents, want)
+	}
+	if len(invoice.Items) != 8 {
+		t.Errorf("%d itens, esperado 8", len(invoice.Items))
+	}
+	if len(invoices.created) != 1 {
+		t.Errorf("%d faturas gravadas, esperado 1", len(invoices.created))
+	}
+
+	if fixed.BilledInvoiceID != invoice.ID || success.BilledInvoiceID != invoice.ID {
+		t.Error("honorários fixo e de êxito devem ficar vinculados à fatura")
+	}
+	if !slices.Equal(retainer.BilledPeriods, []string{"2026-01", "2026-02", "2026-03"}) {
+		t.Errorf("competências cobradas = %v", retainer.BilledPeriods)
+	}
+	if len(agreements.saved) != 3 {
+		t.Errorf("%d contratos gravados, esperado 3", len(agreements.saved))
+	}
+}
+
+func TestGenerateConcurrentInvoice(t *testing.T) {
+	entries := &entryRepoStub{
+		entries:  []*domain.TimeEntry{{ID: primitive.NewObjectID(), Minutes: 60, RateCents: 30000, AmountCents: 30000}},
+		claimErr: repositories.ErrAlreadyInvoiced,
+	}
+	expenses := &expenseRepoStub{}
+	agreements := &agreementRepoStub{}
+	invoices := &invoiceRepoStub{}
+
+	_, err := newInvoiceServiceStub(entries, expenses, agreements, invoices).Generate(domain.InvoiceGeneration{
+		ClientID:  primitive.NewObjectID(),
+		PeriodEnd: mustDate(t, "2026-03-15"),
+	})
+	if !errors.Is(err, ErrConcurrentInvoice) {
+		t.Fatalf("Generate = %v, esperado ErrConcurrentInvoice", err)
+	}
+	if len(invoices.created) != 0 {
+		t.Error("a fatura não deve ser gravada quando os lançamentos já foram faturados")
+	}
+	if entries.released != 1 || expenses.released != 1 {
+		t.Error("os vínculos parciais devem ser desfeitos")
+	}
+}
+
+func TestGenerateReleasesClaimedAgreements(t *testing.T) {
+	retainer := &domain.FeeAgreement{ID: primitive.NewObjectID(), Type: domain.FeeTypeRetainer, AmountCents: 200000, StartDate: mustDate(t, "2026-01-10")}
+	fixed := &domain.FeeAgreement{ID: primitive.NewObjectID(), Type: domain.FeeTypeFixed, AmountCents: 500000, StartDate: mustDate(t, "2026-01-01")}
+	agreements := &agreementRepoStub{agreements: []*domain.FeeAgreement{retainer, fixed}, conflictID: fixed.ID}
+	invoices := &invoiceRepoStub{}
+
+	_, err := newInvoiceServiceStub(&entryRepoStub{}, &expenseRepoStub{}, agreements, invoices).Generate(domain.InvoiceGeneration{
+		ClientID:  primitive.NewObjectID(),
+		PeriodEnd: mustDate(t, "2026-02-15"),
+	})
+	if !errors.Is(err, ErrConcurrentInvoice) {
+		t.Fatalf("Generate = %v, esperado ErrConcurrentInvoice", err)
+	}
+
+	// Só o contrato gravado por esta geração é liberado, e apenas nas
+	// competências que ela cobrou
+	if len(agreements.released) != 1 || !slices.Equal(agreements.released[retainer.ID], []string{"2026-01", "2026-02"}) {
+		t.Errorf("contratos liberados = %v", agreements.released)
+	}
+}
+
+func TestGenerateNothingToInvoice(t *testing.T) {
+	_, err := newInvoiceServiceStub(&entryRepoStub{}, &expenseRepoStub{}, &agreementRepoStub{}, &invoiceRepoStub{}).Generate(domain.InvoiceGeneration{
+		ClientID:  primitive.NewObjectID(),
+		PeriodEnd: mustDate(t, "2026-03-15"),
+	})
+	if !errors.Is(err, ErrNothingToInvoice) {
+		t.Errorf("Generate = %v, esperado ErrNothingToInvoice", err)
+	}
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/config"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/mail"
+	"github.com/jurisconnect/backend/internal/repositories"
+)
+
+// ErrLoginLocked é retornado quando a conta ou o IP está bloqueado por excesso de falhas
+var ErrLoginLocked = errors.New("muitas tentativas de login sem sucesso; acesso bloqueado temporariamente")
+
+// LoginLockedError informa até quando o login está bloqueado
+type LoginLockedError struct {
+	Until time.Time
+}
+
+func (e *LoginLockedError) Error() string {
+	return fmt.Sprintf("%v até %s", ErrLoginLocked, e.Until.Format("02/01/2006 15:04"))
+}
+
+func (e *LoginLockedError) Unwrap() error {
+	return ErrLoginLocked
+}
+
+// LoginThrottleService protege o login contra tentativas de senha, contando
+// as falhas por conta e por IP. Contas inexistentes também são contadas, para
+// que o bloqueio não revele quais emails estão cadastrados.
+type LoginThrottleService struct {
+	throttleRepo domain.LoginThrottleRepository
+	userRepo     domain.UserRepository
+	mailer       mail.Mailer
+	policy       config.LoginConfig
+}
+
+func NewLoginThrottleService(throttleRepo domain.LoginThrottleRepository, userRepo domain.UserRepository, mailer mail.Mailer, policy config.LoginConfig) *LoginThrottleService {
+	return &LoginThrottleService{throttleRepo: throttleRepo, userRepo: userRepo, mailer: mailer, policy: policy}
+}
+
+// throttleKey identifica um registro do limitador e o limite de falhas dele
+type throttleKey struct {
+	scope       domain.LoginThrottleScope
+	subject     string
+	maxAttempts int
+}
+
+func (s *LoginThrottleService) keys(email, ip string) []throttleKey {
+	keys := []throttleKey{{scope: domain.LoginThrottleAccount, subject: normalizeLoginEmail(email), maxAttempts: s.policy.MaxAttempts}}
+	if ip != "" {
+		keys = append(keys, throttleKey{scope: domain.LoginThrottleIP, subject: ip, maxAttempts: s.policy.MaxAttemptsPerIP})
+	}
+	return keys
+}
+
+func normalizeLoginEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// Check retorna LoginLockedError se a conta ou o IP estiver bloqueado
+func (s *LoginThrottleService) Check(email, ip string) error {
+	now := time.Now()
+	var until time.Time
+	for _, key := range s.keys(email, ip) {
+		throttle, err := s.throttleRepo.Find(key.scope, key.subject)
+		if errors.Is(err, repositories.ErrLoginThrottleNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if throttle.IsLocked(now) && throttle.LockedUntil.After(until) {
+			until = *throttle.LockedUntil
+		}
+	}
+
+	if !until.IsZero() {
+		return &LoginLockedError{Until: until}
+	}
+	return nil
+}
+
+// RecordFailure registra uma senha errada e bloqueia a conta ou o IP que
+// atingir o limite de falhas; o titular da conta é avisado por e-mail
+func (s *LoginThrottleService) RecordFailure(email, ip string) error {
+	now := time.Now()
+	for _, key := range s.keys(email, ip) {
+		if key.maxAttempts <= 0 {
+			continue
+		}
+
+		throttle, err := s.throttleRepo.RecordFailure(key.scope, key.subject, now, s.policy.AttemptWindow, now.Add(s.policy.MaxLockout))
+		if err != nil {
+			return err
+		}
+		if throttle.Failures < key.maxAttempts || throttle.IsLocked(now) {
+			continue
+		}
+
+		until := now.Add(s.lockoutDuration(throttle.Lockouts))
+		if err := s.throttleRepo.Lock(throttle.ID, until, until.Add(s.policy.MaxLockout)); err != nil {
+			return err
+		}
+		log.Printf("Login bloqueado para %s %s até %s após %d falhas", key.scope, key.subject, until.Format(time.RFC3339), throttle.Failures)
+
+		if key.scope == domain.LoginThrottleAccount {
+			s.notifyLocked(email, ip, throttle.Failures, until)
+		}
+	}
+	return nil
+}
+
+// RecordRequest conta um pedido de subject dentro da janela e informa se o
+// limite foi excedido. Usado para limitar ações sem senha, como os pedidos de
+// redefinição; limite zero desativa a contagem.
+func (s *LoginThrottleService) RecordRequest(scope domain.LoginThrottleScope, subject string, limit int, window time.Duration) (bool, error) {
+	if limit <= 0 || subject == "" {
+		return false, nil
+	}
+	now := time.Now()
+	throttle, err := s.throttleRepo.RecordFailure(scope, subject, now, window, now.Add(window))
+	if err != nil {
+		return false, err
+	}
+	return throttle.Failures > limit, nil
+}
+
+// lockoutDuration dobra o tempo de bloqueio a cada bloqueio anterior, até o máximo
+func (s *LoginThrottleService) lockoutDuration(previousLockouts int) time.Duration {
+	duration := s.policy.Lockout
+	for i := 0; i < previousLockouts && duration < s.policy.MaxLockout; i++ {
+		duration *= 2
+	}
+	if s.policy.MaxLockout > 0 && duration > s.policy.MaxLockout {
+		duration = s.policy.MaxLockout
+	}
+	return duration
+}
+
+func (s *LoginThrottleService) notifyLocked(email, ip string, failures int, until time.Time) {
+	user, err := s.userRepo.FindByEmail(email)
+	if err != nil {
+		// Conta inexistente: não há a quem avisar
+		return
+	}
+
+	sendMail(s.mailer, mail.Message{
+		To:      []string{user.PersonalInfo.Email},
+		Subject: "JurisConnect: acesso à sua conta bloqueado temporariamente",
+		Body: fmt.Sprintf("Olá, %s.\n\n"+
+			"Registramos %d tentativas de login sem sucesso na sua conta, a última a partir do IP %s. "+
+			"Por segurança, o acesso foi bloqueado até %s.\n\n"+
+			"Se foi você, aguarde o fim do bloqueio ou peça a um administrador que desbloqueie a conta. "+
+			"Se não reconhece essas tentativas, troque sua senha assim que possível e avise o administrador do escritório.\n",
+			user.PersonalInfo.Name, failures, ip, until.Format("02/01/2006 15:04")),
+	})
+}
+
+// RecordSuccess zera as falhas da conta após um login bem-sucedido. A contagem
+// de bloqueios é mantida, para que acertar a senha uma vez não reinicie o tempo
+// dobrado; ela cai com a expiração do registro ou no desbloqueio pelo
+// administrador. As falhas do IP também são mantidas, para que uma conta
+// válida não sirva para zerá-las.
+func (s *LoginThrottleService) RecordSuccess(email string) error {
+	return s.throttleRepo.ResetFailures(domain.LoginThrottleAccount, normalizeLoginEmail(email))
+}
+
+// GetLocked lista as contas e IPs bloqueados no momento
+func (s *LoginThrottleService) GetLocked() ([]*domain.LoginThrottle, error) {
+	return s.throttleRepo.FindLocked(time.Now())
+}
+
+// UnlockUser desbloqueia a conta do usuário e zera o histórico de falhas
+func (s *LoginThrottleService) UnlockUser(userID string) error {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return err
+	}
+	return s.throttleRepo.Reset(domain.LoginThrottleAccount, normalizeLoginEmail(user.PersonalInfo.Email))
+}
+
+// Unlock remove um registro do limitador, desbloqueando a conta ou o IP
+func (s *LoginThrottleService) Unlock(id string) error {
+	return s.throttleRepo.Delete(id)
+}
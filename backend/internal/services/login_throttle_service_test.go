@@ -0,0 +1,266 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/config"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestLockoutDuration(t *testing.T) {
+	service := &LoginThrottleService{policy: config.LoginConfig{Lockout: 15 * time.Minute, MaxLockout: 24 * time.Hour}}
+
+	tests := map[int]time.Duration{
+		0:  15 * time.Minute,
+		1:  30 * time.Minute,
+		2:  time.Hour,
+		3:  2 * time.Hour,
+		6:  16 * time.Hour,
+		7:  24 * time.Hour,
+		50: 24 * time.Hour,
+	}
+
+	for lockouts, want := range tests {
+		if got := service.lockoutDuration(lockouts); got != want {
+			t.Errorf("lockoutDuration(%d) = %v, esperado %v", lockouts, got, want)
+		}
+	}
+}
+
+func TestLockoutDurationWithoutMaximum(t *testing.T) {
+	service := &LoginThrottleService{policy: config.LoginConfig{Lockout: 15 * time.Minute}}
+
+	if got := service.lockoutDuration(5); got != 15*time.Minute {
+		t.Errorf("sem bloqueio máximo o tempo não dobra: %v", got)
+	}
+}
+
+// throttleRepoFake guarda os registros do limitador em memória, com a mesma
+// semântica de janela e bloqueio do repositório MongoDB
+type throttleRepoFake struct {
+	records map[string]*domain.LoginThrottle
+}
+
+func newThrottleRepoFake() *throttleRepoFake {
+	return &throttleRepoFake{records: map[string]*domain.LoginThrottle{}}
+}
+
+func (r *throttleRepoFake) Find(scope domain.LoginThrottleScope, subject string) (*domain.LoginThrottle, error) {
+	throttle, ok := r.records[string(scope)+":"+subject]
+	if !ok {
+		return nil, repositories.ErrLoginThrottleNotFound
+	}
+	copied := *throttle
+	return &copied, nil
+}
+
+func (r *throttleRepoFake) RecordFailure(scope domain.LoginThrottleScope, subject string, now time.Time, window time.Duration, expiresAt time.Time) (*domain.LoginThrottle, error) {
+	key := string(scope) + ":" + subject
+	throttle, ok := r.records[key]
+	if !ok {
+		throttle = &domain.LoginThrottle{ID: primitive.NewObjectID(), Scope: scope, Subject: subject}
+		r.records[key] = throttle
+	}
+	if throttle.WindowStart.After(now.Add(-window)) {
+		throttle.Failures++
+	} else {
+		throttle.Failures = 1
+		throttle.WindowStart = now
+	}
+	throttle.LastFailureAt = now
+	if expiresAt.After(throttle.ExpiresAt) {
+		throttle.ExpiresAt = expiresAt
+	}
+	copied := *throttle
+	return &copied, nil
+}
+
+func (r *throttleRepoFake) Lock(id primitive.ObjectID, until time.Time, expiresAt time.Time) error {
+	for _, throttle := range r.records {
+		if throttle.ID == id {
+			throttle.LockedUntil = &until
+			throttle.Failures = 0
+			throttle.Lockouts++
+			return nil
+		}
+	}
+	return repositories.ErrLoginThrottleNotFound
+}
+
+func (r *throttleRepoFake) FindLocked(now time.Time) ([]*domain.LoginThrottle, error) {
+	locked := []*domain.LoginThrottle{}
+	for _, throttle := range r.records {
+		if throttle.IsLocked(now) {
+			locked = append(locked, throttle)
+		}
+	}
+	return locked, nil
+}
+
+func (r *throttleRepoFake) ResetFailures(scope domain.LoginThrottleScope, subject string) error {
+	if throttle, ok := r.records[string(scope)+":"+subject]; ok {
+		throttle.Failures = 0
+		throttle.LockedUntil = nil
+	}
+	return nil
+}
+
+func (r *throttleRepoFake) Reset(scope domain.LoginThrottleScope, subject string) error {
+	delete(r.records, string(scope)+":"+subject)
+	return nil
+}
+
+func (r *throttleRepoFake) Delete(id string) error {
+	for key, throttle := range r.records {
+		if throttle.ID.Hex() == id {
+			delete(r.records, key)
+			return nil
+		}
+	}
+	return repositories.ErrLoginThrottleNotFound
+}
+
+// throttleUserStub não conhece nenhum email: nenhum aviso é enviado
+type throttleUserStub struct {
+	domain.UserRepository
+}
+
+func (throttleUserStub) FindByEmail(email string) (*domain.User, error) {
+	return nil, repositories.ErrUserNotFound
+}
+
+func newThrottleFixture(repo *throttleRepoFake) *LoginThrottleService {
+	return NewLoginThrottleService(repo, throttleUserStub{}, nil, config.LoginConfig{
+		MaxAttempts:      3,
+		MaxAttemptsPerIP: 5,
+		AttemptWindow:    15 * time.Minute,
+		Lockout:          15 * time.Minute,
+		MaxLockout:       24 * time.Hour,
+	})
+}
+
+// lockedFor devolve a duração do bloqueio vigente da conta
+func lockedFor(t *testing.T, repo *throttleRepoFake, email string) time.Duration {
+	t.Helper()
+	throttle, err := repo.Find(domain.LoginThrottleAccount, email)
+	if err != nil {
+		t.Fatalf("Find(%s): %v", email, err)
+	}
+	if throttle.LockedUntil == nil {
+		return 0
+	}
+	return throttle.LockedUntil.Sub(throttle.LastFailureAt)
+}
+
+// expireLock simula o fim do bloqueio da conta
+func expireLock(repo *throttleRepoFake, email string) {
+	past := time.Now().Add(-time.Minute)
+	repo.records[string(domain.LoginThrottleAccount)+":"+email].LockedUntil = &past
+}
+
+func TestLoginThrottleLocksAccount(t *testing.T) {
+	repo := newThrottleRepoFake()
+	service := newThrottleFixture(repo)
+
+	for i := 0; i < 2; i++ {
+		if err := service.RecordFailure("Ana@Escritorio.com.br ", "10.0.0.1"); err != nil {
+			t.Fatalf("RecordFailure: %v", err)
+		}
+	}
+	if err := service.Check("ana@escritorio.com.br", "10.0.0.2"); err != nil {
+		t.Fatalf("abaixo do limite: Check = %v", err)
+	}
+
+	if err := service.RecordFailure("ana@escritorio.com.br", "10.0.0.1"); err != nil {
+		t.Fatalf("RecordFailure: %v", err)
+	}
+	// O bloqueio vale para a conta a partir de qualquer IP
+	var locked *LoginLockedError
+	if err := service.Check("ana@escritorio.com.br", "10.0.0.2"); !errors.As(err, &locked) {
+		t.Fatalf("Check = %v, esperado LoginLockedError", err)
+	}
+	if got := lockedFor(t, repo, "ana@escritorio.com.br"); got != 15*time.Minute {
+		t.Errorf("primeiro bloqueio de %v, esperado 15m", got)
+	}
+}
+
+func TestLoginThrottleSuccessKeepsLockouts(t *testing.T) {
+	repo := newThrottleRepoFake()
+	service := newThrottleFixture(repo)
+	const email = "ana@escritorio.com.br"
+
+	// Sem IP, para que só o limite da conta seja exercitado
+	fail := func(times int) {
+		t.Helper()
+		for i := 0; i < times; i++ {
+			if err := service.RecordFailure(email, ""); err != nil {
+				t.Fatalf("RecordFailure: %v", err)
+			}
+		}
+	}
+
+	fail(3)
+	expireLock(repo, email)
+
+	// Acertar a senha zera as falhas, mas não a contagem de bloqueios
+	fail(2)
+	if err := service.RecordSuccess(email); err != nil {
+		t.Fatalf("RecordSuccess: %v", err)
+	}
+	throttle, err := repo.Find(domain.LoginThrottleAccount, email)
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+	if throttle.Failures != 0 || throttle.Lockouts != 1 {
+		t.Errorf("após o login: %d falhas e %d bloqueios, esperado 0 e 1", throttle.Failures, throttle.Lockouts)
+	}
+
+	fail(2)
+	if err := service.Check(email, ""); err != nil {
+		t.Fatalf("falhas anteriores ao login não contam: Check = %v", err)
+	}
+	fail(1)
+	if got := lockedFor(t, repo, email); got != 30*time.Minute {
+		t.Errorf("segundo bloqueio de %v, esperado 30m", got)
+	}
+
+	// O desbloqueio pelo administrador reinicia a escala
+	if err := service.throttleRepo.Reset(domain.LoginThrottleAccount, email); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	fail(3)
+	if got := lockedFor(t, repo, email); got != 15*time.Minute {
+		t.Errorf("bloqueio após o desbloqueio de %v, esperado 15m", got)
+	}
+}
+
+func TestLoginThrottleLocksIP(t *testing.T) {
+	repo := newThrottleRepoFake()
+	service := newThrottleFixture(repo)
+
+	// Cinco contas diferentes, uma falha cada: só o limite por IP é atingido
+	for _, email := range []string{"a@x.com", "b@x.com", "c@x.com", "d@x.com", "e@x.com"} {
+		if err := service.RecordFailure(email, "10.0.0.9"); err != nil {
+			t.Fatalf("RecordFailure: %v", err)
+		}
+	}
+
+	if err := service.Check("f@x.com", "10.0.0.9"); !errors.Is(err, ErrLoginLocked) {
+		t.Errorf("IP bloqueado: Check = %v, esperado ErrLoginLocked", err)
+	}
+	if err := service.Check("a@x.com", "10.0.0.10"); err != nil {
+		t.Errorf("a conta não foi bloqueada: Check = %v", err)
+	}
+
+	// Um login válido a partir do IP não zera as falhas dele
+	if err := service.RecordSuccess("a@x.com"); err != nil {
+		t.Fatalf("RecordSuccess: %v", err)
+	}
+	if err := service.Check("f@x.com", "10.0.0.9"); !errors.Is(err, ErrLoginLocked) {
+		t.Errorf("após login válido: Check = %v, esperado ErrLoginLocked", err)
+	}
+}
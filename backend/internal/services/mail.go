@@ -0,0 +1,18 @@
+package services
+
+import (
+	"log"
+	"strings"
+
+	"github.com/jurisconnect/backend/internal/mail"
+)
+
+// sendMail envia o e-mail em segundo plano, para não atrasar a resposta da
+// requisição; falhas ficam apenas no log
+func sendMail(mailer mail.Mailer, msg mail.Message) {
+	go func() {
+		if err := mailer.Send(msg); err != nil {
+			log.Printf("Aviso: falha ao enviar e-mail \"%s\" para %s: %v", msg.Subject, strings.Join(msg.To, ", "), err)
+		}
+	}()
+}
@@ -0,0 +1,284 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/config"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/mail"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"github.com/jurisconnect/backend/internal/security"
+)
+
+var (
+	// ErrInvalidResetToken é retornado quando o link de redefinição não existe, expirou ou já foi usado
+	ErrInvalidResetToken = errors.New("link de redefinição de senha inválido ou expirado")
+
+	// ErrWrongPassword é retornado quando a senha atual informada não confere
+	ErrWrongPassword = errors.New("senha atual incorreta")
+
+	// ErrPasswordChangeRequired é retornado enquanto o usuário não troca a senha temporária
+	ErrPasswordChangeRequired = errors.New("é necessário trocar a senha temporária antes de continuar")
+
+	// ErrTooManyResetRequests é retornado quando o email ou o IP excede os pedidos de redefinição
+	ErrTooManyResetRequests = errors.New("muitos pedidos de redefinição de senha; tente novamente mais tarde")
+)
+
+// temporaryPasswordLength é o tamanho da senha temporária gerada pelo administrador
+const temporaryPasswordLength = 12
+
+// PasswordService cuida da redefinição ("esqueci minha senha"), da troca pelo
+// próprio usuário e da senha temporária definida por um administrador. Toda
+// troca de senha encerra as sessões abertas do usuário.
+type PasswordService struct {
+	userRepo      domain.UserRepository
+	resetRepo     domain.PasswordResetTokenRepository
+	authService   *AuthService
+	loginThrottle *LoginThrottleService
+	mailer        mail.Mailer
+	policy        config.PasswordResetConfig
+}
+
+func NewPasswordService(userRepo domain.UserRepository, resetRepo domain.PasswordResetTokenRepository, authService *AuthService, loginThrottle *LoginThrottleService, mailer mail.Mailer, policy config.PasswordResetConfig) *PasswordService {
+	return &PasswordService{
+		userRepo:      userRepo,
+		resetRepo:     resetRepo,
+		authService:   authService,
+		loginThrottle: loginThrottle,
+		mailer:        mailer,
+		policy:        policy,
+	}
+}
+
+// RequestReset envia por e-mail um link de redefinição de senha. Emails não
+// cadastrados e contas desativadas são ignorados sem erro, para que a resposta
+// não revele quais contas existem. Os pedidos são limitados por email e por
+// IP, contando também os de emails não cadastrados.
+func (s *PasswordService) RequestReset(email, ip string) error {
+	if err := s.throttleRequest(email, ip); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.FindByEmail(strings.TrimSpace(email))
+	if errors.Is(err, repositories.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !user.IsActive {
+		return nil
+	}
+
+	// Um novo pedido invalida os links enviados antes
+	if err := s.resetRepo.DeleteByUser(user.ID.Hex()); err != nil {
+		return err
+	}
+
+	rawToken, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	token := &domain.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: security.HashToken(rawToken),
+		RequestIP: ip,
+		ExpiresAt: now.Add(s.policy.Expiry),
+		CreatedAt: now,
+	}
+	if err := s.resetRepo.Create(token); err != nil {
+		return err
+	}
+
+	sendMail(s.mailer, mail.Message{
+		To:      []string{user.PersonalInfo.Email},
+		Subject: "JurisConnect: redefinição de senha",
+		Body: fmt.Sprintf("Olá, %s.\n\n"+
+			"Recebemos um pedido para redefinir a senha da sua conta. Para criar uma nova senha, acesse o link abaixo até %s:\n\n"+
+			"%s\n\n"+
+			"O link só pode ser usado uma vez. Se você não fez este pedido, ignore este e-mail; sua senha atual continua válida.\n",
+			user.PersonalInfo.Name, token.ExpiresAt.Format("02/01/2006 15:04"), s.resetLink(rawToken)),
+	})
+	return nil
+}
+
+// throttleRequest conta o pedido para o email e para o IP, evitando que o
+// formulário sirva para inundar caixas de e-mail
+func (s *PasswordService) throttleRequest(email, ip string) error {
+	exceeded, err := s.loginThrottle.RecordRequest(domain.PasswordResetThrottleIP, ip, s.policy.MaxRequestsPerIP, s.policy.RequestWindow)
+	if err != nil {
+		return err
+	}
+	if exceeded {
+		return ErrTooManyResetRequests
+	}
+
+	exceeded, err = s.loginThrottle.RecordRequest(domain.PasswordResetThrottleAccount, normalizeLoginEmail(email), s.policy.MaxRequests, s.policy.RequestWindow)
+	if err != nil {
+		return err
+	}
+	if exceeded {
+		return ErrTooManyResetRequests
+	}
+	return nil
+}
+
+func (s *PasswordService) resetLink(rawToken string) string {
+	separator := "?"
+	if strings.Contains(s.policy.URL, "?") {
+		separator = "&"
+	}
+	return s.policy.URL + separator + "token=" + url.QueryEscape(rawToken)
+}
+
+// ResetPassword define a nova senha a partir do token recebido por e-mail
+func (s *PasswordService) ResetPassword(rawToken, newPassword string) error {
+	// A senha é validada antes de consumir o token, para que uma senha fraca
+	// não obrigue o usuário a pedir outro link
+	if err := validateNewPassword(newPassword); err != nil {
+		return err
+	}
+
+	token, err := s.resetRepo.FindByHash(security.HashToken(rawToken))
+	if errors.Is(err, repositories.ErrPasswordResetTokenNotFound) {
+		return ErrInvalidResetToken
+	}
+	if err != nil {
+		return err
+	}
+	if token.UsedAt != nil || time.Now().After(token.ExpiresAt) {
+		return ErrInvalidResetToken
+	}
+
+	if err := s.resetRepo.MarkUsed(token.ID); err != nil {
+		if errors.Is(err, repositories.ErrPasswordResetTokenUsed) {
+			return ErrInvalidResetToken
+		}
+		return err
+	}
+
+	user, err := s.userRepo.FindByID(token.UserID.Hex())
+	if errors.Is(err, repositories.ErrUserNotFound) {
+		return ErrInvalidResetToken
+	}
+	if err != nil {
+		return err
+	}
+	if !user.IsActive {
+		return ErrUserInactive
+	}
+
+	if err := s.setPassword(user, newPassword, false); err != nil {
+		return err
+	}
+
+	// Quem recuperou o acesso pelo e-mail não deve continuar bloqueado
+	if err := s.loginThrottle.RecordSuccess(user.PersonalInfo.Email); err != nil {
+		log.Printf("Aviso: falha ao zerar tentativas de login: %v", err)
+	}
+	s.notifyChanged(user)
+	return nil
+}
+
+// ChangePassword troca a senha do usuário autenticado, exigindo a senha atual
+func (s *PasswordService) ChangePassword(user *domain.User, currentPassword, newPassword string) error {
+	if !security.CheckPassword(currentPassword, user.Password) {
+		return ErrWrongPassword
+	}
+	if currentPassword == newPassword {
+		return newValidationError("a nova senha deve ser diferente da atual")
+	}
+	if err := validateNewPassword(newPassword); err != nil {
+		return err
+	}
+
+	if err := s.setPassword(user, newPassword, false); err != nil {
+		return err
+	}
+	s.notifyChanged(user)
+	return nil
+}
+
+// ResetByAdmin gera uma senha temporária para o usuário, que terá de trocá-la
+// no próximo acesso. A senha é retornada uma única vez, para o administrador
+// repassá-la ao usuário.
+func (s *PasswordService) ResetByAdmin(id string) (string, error) {
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		return "", err
+	}
+
+	password, err := security.GenerateRandomPassword(temporaryPasswordLength)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.setPassword(user, password, true); err != nil {
+		return "", err
+	}
+
+	// A senha definida pelo administrador equivale a um desbloqueio da conta
+	if err := s.loginThrottle.UnlockUser(id); err != nil {
+		log.Printf("Aviso: falha ao zerar tentativas de login: %v", err)
+	}
+	return password, nil
+}
+
+func validateNewPassword(password string) error {
+	if err := security.ValidatePasswordStrength(password); err != nil {
+		return newValidationError("%v", err)
+	}
+	return nil
+}
+
+// setPassword grava a nova senha, invalida os links de redefinição pendentes e
+// encerra todas as sessões do usuário
+func (s *PasswordService) setPassword(user *domain.User, password string, mustChange bool) error {
+	hashedPassword, err := security.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	user.Password = hashedPassword
+	user.MustChangePassword = mustChange
+	user.PasswordChangedAt = &now
+	user.UpdatedAt = now
+	if err := s.userRepo.Update(user); err != nil {
+		return err
+	}
+
+	if err := s.resetRepo.DeleteByUser(user.ID.Hex()); err != nil {
+		return err
+	}
+	if err := s.authService.LogoutAll(user.ID.Hex()); err != nil {
+		return err
+	}
+
+	// Os tokens emitidos em seguida, como os da troca de senha, precisam da
+	// nova geração de sessões
+	current, err := s.userRepo.FindByID(user.ID.Hex())
+	if err != nil {
+		return err
+	}
+	user.TokenGeneration = current.TokenGeneration
+	return nil
+}
+
+func (s *PasswordService) notifyChanged(user *domain.User) {
+	sendMail(s.mailer, mail.Message{
+		To:      []string{user.PersonalInfo.Email},
+		Subject: "JurisConnect: sua senha foi alterada",
+		Body: fmt.Sprintf("Olá, %s.\n\n"+
+			"A senha da sua conta foi alterada em %s e as sessões abertas foram encerradas.\n\n"+
+			"Se não foi você, procure imediatamente o administrador do escritório.\n",
+			user.PersonalInfo.Name, time.Now().Format("02/01/2006 15:04")),
+	})
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/pix"
+	"github.com/jurisconnect/backend/internal/repositories"
+)
+
+// ErrPixNotConfigured é retornado quando a chave Pix do escritório não foi configurada
+var ErrPixNotConfigured = errors.New("cobrança Pix não configurada: defina PIX_KEY")
+
+// pixTxIDPrefix identifica no extrato os Pix de faturas do sistema
+const pixTxIDPrefix = "JC"
+
+type PixService struct {
+	invoiceRepo    domain.InvoiceRepository
+	invoiceService *InvoiceService
+	merchant       pix.Merchant
+}
+
+func NewPixService(invoiceRepo domain.InvoiceRepository, invoiceService *InvoiceService, merchant pix.Merchant) *PixService {
+	return &PixService{
+		invoiceRepo:    invoiceRepo,
+		invoiceService: invoiceService,
+		merchant:       merchant,
+	}
+}
+
+// invoiceTxID deriva o txid do número da fatura ("2026/000123" → "JC2026000123")
+func invoiceTxID(number string) string {
+	return pixTxIDPrefix + strings.ReplaceAll(number, "/", "")
+}
+
+// Charge gera o BR Code estático da fatura emitida ou vencida, com o valor
+// total e o txid da fatura
+func (s *PixService) Charge(invoiceID string) (*domain.PixCharge, error) {
+	if s.merchant.Key == "" {
+		return nil, ErrPixNotConfigured
+	}
+
+	invoice, err := s.invoiceService.GetByID(invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if !invoice.Status.IsReceivable() {
+		return nil, fmt.Errorf("%w: apenas faturas emitidas ou vencidas têm cobrança Pix", ErrInvalidInvoiceTransition)
+	}
+
+	// Faturas emitidas antes da cobrança Pix recebem o txid na primeira
+	// geração. Só o txid é gravado, para não desfazer uma baixa feita ao
+	// mesmo tempo pela conciliação; o valor deriva do número e é sempre o mesmo.
+	if invoice.PixTxID == "" {
+		invoice.PixTxID = invoiceTxID(invoice.Number)
+		if err := s.invoiceRepo.SetPixTxID(invoice.ID, invoice.PixTxID); err != nil {
+			return nil, err
+		}
+	}
+
+	brcode, err := pix.Payload{
+		Merchant:    s.merchant,
+		AmountCents: invoice.TotalCents,
+		TxID:        invoice.PixTxID,
+		Description: "Fatura " + invoice.Number,
+	}.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PixCharge{
+		InvoiceID:   invoice.ID,
+		Number:      invoice.Number,
+		TxID:        invoice.PixTxID,
+		AmountCents: invoice.TotalCents,
+		DueDate:     invoice.DueDate,
+		BRCode:      brcode,
+	}, nil
+}
+
+// QRCode gera a imagem PNG do BR Code da fatura
+func (s *PixService) QRCode(invoiceID string, size int) ([]byte, error) {
+	charge, err := s.Charge(invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	return pix.QRCode(charge.BRCode, size)
+}
+
+// Reconcile dá baixa nas faturas cujos txid aparecem no extrato de Pix
+// recebidos. Linhas já conciliadas são ignoradas, o que permite reenviar o
+// mesmo extrato; pagamentos inferiores ao total ficam como rejeitados.
+func (s *PixService) Reconcile(statement io.Reader) (*domain.PixReconciliation, error) {
+	credits, err := pix.ParseStatement(statement)
+	if err != nil {
+		return nil, newValidationError("extrato inválido: %v", err)
+	}
+
+	result := &domain.PixReconciliation{Total: len(credits), Matches: make([]domain.PixMatch, 0, len(credits))}
+	for _, credit := range credits {
+		match := domain.PixMatch{
+			TxID:        credit.TxID,
+			EndToEndID:  credit.EndToEndID,
+			AmountCents: credit.AmountCents,
+			PaidAt:      credit.PaidAt,
+		}
+		s.reconcileCredit(&match)
+
+		switch match.Status {
+		case domain.PixMatchPaid:
+			result.Paid++
+		case domain.PixMatchAlreadyPaid:
+			result.AlreadyPaid++
+		case domain.PixMatchNotFound:
+			result.NotFound++
+		default:
+			result.Rejected++
+		}
+		result.Matches = append(result.Matches, match)
+	}
+
+	return result, nil
+}
+
+func (s *PixService) reconcileCredit(match *domain.PixMatch) {
+	if pix.ValidateTxID(match.TxID) != nil {
+		match.Status = domain.PixMatchNotFound
+		return
+	}
+	if match.AmountCents <= 0 {
+		match.Status = domain.PixMatchRejected
+		match.Error = "valor recebido inválido"
+		return
+	}
+
+	invoice, err := s.invoiceRepo.FindByPixTxID(match.TxID)
+	if err != nil {
+		match.Status = domain.PixMatchNotFound
+		if !errors.Is(err, repositories.ErrInvoiceNotFound) {
+			match.Status = domain.PixMatchRejected
+			match.Error = err.Error()
+		}
+		return
+	}
+	match.InvoiceID = invoice.ID
+	match.Number = invoice.Number
+
+	if invoice.Status == domain.InvoiceStatusPaid {
+		match.Status = domain.PixMatchAlreadyPaid
+		return
+	}
+
+	if _, err := s.invoiceService.MarkPaid(invoice.ID.Hex(), match.PaidAt, match.AmountCents); err != nil {
+		match.Status = domain.PixMatchRejected
+		match.Error = err.Error()
+		return
+	}
+	match.Status = domain.PixMatchPaid
+}
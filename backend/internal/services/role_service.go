@@ -0,0 +1,195 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+)
+
+var (
+	// ErrInvalidRole é retornado quando a role informada não existe
+	ErrInvalidRole = errors.New("role inválida")
+
+	// ErrSystemRole é retornado ao tentar remover uma role predefinida ou alterar a role de administrador
+	ErrSystemRole = errors.New("roles predefinidas não podem ser removidas e a role admin não pode ser alterada")
+
+	// ErrRoleInUse é retornado ao tentar remover uma role atribuída a usuários
+	ErrRoleInUse = errors.New("role está atribuída a usuários")
+)
+
+// roleNamePattern aceita letras (inclusive acentuadas), números, "_" e "-"
+var roleNamePattern = regexp.MustCompile(`^[\p{L}0-9_-]{2,50}$`)
+
+type RoleService struct {
+	roleRepo domain.RoleRepository
+	userRepo domain.UserRepository
+}
+
+func NewRoleService(roleRepo domain.RoleRepository, userRepo domain.UserRepository) *RoleService {
+	return &RoleService{roleRepo: roleRepo, userRepo: userRepo}
+}
+
+// NormalizeRoleName padroniza o nome da role para comparação e armazenamento
+func NormalizeRoleName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func (s *RoleService) validate(role *domain.Role) error {
+	if !roleNamePattern.MatchString(role.Name) {
+		return errors.New("nome da role deve ter entre 2 e 50 caracteres entre letras, números, \"_\" e \"-\"")
+	}
+
+	seen := make(map[string]bool)
+	for _, permission := range role.Permissions {
+		if !contains(domain.Modules, permission.Module) {
+			return fmt.Errorf("módulo desconhecido: %s", permission.Module)
+		}
+		if seen[permission.Module] {
+			return fmt.Errorf("módulo repetido: %s", permission.Module)
+		}
+		seen[permission.Module] = true
+
+		for _, action := range permission.Actions {
+			if !contains(domain.Actions, action) {
+				return fmt.Errorf("ação desconhecida: %s", action)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *RoleService) Create(role *domain.Role) error {
+	role.Name = NormalizeRoleName(role.Name)
+	if err := s.validate(role); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	role.IsSystem = false
+	role.CreatedAt = now
+	role.UpdatedAt = now
+
+	return s.roleRepo.Create(role)
+}
+
+func (s *RoleService) GetByName(name string) (*domain.Role, error) {
+	return s.roleRepo.FindByName(NormalizeRoleName(name))
+}
+
+func (s *RoleService) List() ([]*domain.Role, error) {
+	return s.roleRepo.FindAll()
+}
+
+// Update altera a descrição e as permissões da role; o nome é imutável
+func (s *RoleService) Update(role *domain.Role) error {
+	existing, err := s.roleRepo.FindByName(NormalizeRoleName(role.Name))
+	if err != nil {
+		return err
+	}
+	if existing.Name == domain.RoleAdmin.Name {
+		return ErrSystemRole
+	}
+
+	existing.Description = role.Description
+	existing.Permissions = role.Permissions
+	if err := s.validate(existing); err != nil {
+		return err
+	}
+	existing.UpdatedAt = time.Now()
+
+	if err := s.roleRepo.Update(existing); err != nil {
+		return err
+	}
+
+	*role = *existing
+	return nil
+}
+
+func (s *RoleService) Delete(name string) error {
+	role, err := s.roleRepo.FindByName(NormalizeRoleName(name))
+	if err != nil {
+		return err
+	}
+	if role.IsSystem {
+		return ErrSystemRole
+	}
+
+	count, err := s.userRepo.CountByRole(role.Name)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrRoleInUse
+	}
+
+	return s.roleRepo.Delete(role.Name)
+}
+
+// SeedDefaults garante que as roles predefinidas existam. Roles já gravadas
+// recebem apenas os módulos novos, preservando ajustes feitos pelos
+// administradores; a role admin é sempre sincronizada com a definição padrão.
+func (s *RoleService) SeedDefaults() error {
+	for _, defaults := range domain.PredefinedRoles {
+		role := defaults
+		existing, err := s.roleRepo.FindByName(role.Name)
+		if err != nil {
+			if !errors.Is(err, repositories.ErrRoleNotFound) {
+				return err
+			}
+			now := time.Now()
+			role.CreatedAt = now
+			role.UpdatedAt = now
+			if err := s.roleRepo.Create(&role); err != nil && !errors.Is(err, repositories.ErrDuplicateRole) {
+				return err
+			}
+			continue
+		}
+
+		changed := !existing.IsSystem
+		existing.IsSystem = true
+		if existing.Name == domain.RoleAdmin.Name {
+			existing.Permissions = role.Permissions
+			changed = true
+		} else {
+			for _, permission := range role.Permissions {
+				if !hasModule(existing.Permissions, permission.Module) {
+					existing.Permissions = append(existing.Permissions, permission)
+					changed = true
+				}
+			}
+		}
+
+		if changed {
+			existing.UpdatedAt = time.Now()
+			if err := s.roleRepo.Update(existing); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func hasModule(permissions []domain.Permission, module string) bool {
+	for _, permission := range permissions {
+		if permission.Module == module {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
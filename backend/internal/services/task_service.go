@@ -0,0 +1,248 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrChecklistItemNotFound é retornado quando o item não pertence ao checklist da tarefa
+var ErrChecklistItemNotFound = errors.New("item do checklist não encontrado")
+
+// maxTaskCommentLength limita o tamanho dos comentários das tarefas
+const maxTaskCommentLength = 2000
+
+type TaskService struct {
+	taskRepo domain.TaskRepository
+	caseRepo domain.CaseRepository
+	userRepo domain.UserRepository
+}
+
+func NewTaskService(taskRepo domain.TaskRepository, caseRepo domain.CaseRepository, userRepo domain.UserRepository) *TaskService {
+	return &TaskService{taskRepo: taskRepo, caseRepo: caseRepo, userRepo: userRepo}
+}
+
+func (s *TaskService) validate(task *domain.Task) error {
+	task.Title = strings.TrimSpace(task.Title)
+	if task.Title == "" {
+		return newValidationError("título da tarefa é obrigatório")
+	}
+	if len(task.Title) > 200 {
+		return newValidationError("título da tarefa deve ter no máximo 200 caracteres")
+	}
+	if task.Priority == "" {
+		task.Priority = domain.TaskPriorityMedium
+	}
+	if !task.Priority.IsValid() {
+		return newValidationError("prioridade inválida: use baixa, media, alta ou urgente")
+	}
+	if !task.Status.IsValid() {
+		return newValidationError("status da tarefa inválido: %s", task.Status)
+	}
+
+	if task.AssigneeID.IsZero() {
+		return newValidationError("responsável pela tarefa é obrigatório")
+	}
+	if _, err := s.userRepo.FindByID(task.AssigneeID.Hex()); err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return newValidationError("responsável pela tarefa não encontrado")
+		}
+		return err
+	}
+
+	if !task.CaseID.IsZero() {
+		if _, err := s.caseRepo.FindByID(task.CaseID.Hex()); err != nil {
+			if errors.Is(err, repositories.ErrCaseNotFound) {
+				return newValidationError("processo não encontrado")
+			}
+			return err
+		}
+	}
+
+	if task.Checklist == nil {
+		task.Checklist = []domain.ChecklistItem{}
+	}
+	for i := range task.Checklist {
+		item := &task.Checklist[i]
+		item.Text = strings.TrimSpace(item.Text)
+		if item.Text == "" {
+			return newValidationError("item %d do checklist: texto é obrigatório", i+1)
+		}
+		if item.ID.IsZero() {
+			item.ID = primitive.NewObjectID()
+		}
+		if !item.Done {
+			item.DoneBy = primitive.NilObjectID
+			item.DoneAt = nil
+		}
+	}
+
+	return nil
+}
+
+// markChecklist registra quem concluiu os itens marcados nesta alteração
+func markChecklist(task *domain.Task, userID primitive.ObjectID, now time.Time) {
+	for i := range task.Checklist {
+		item := &task.Checklist[i]
+		if item.Done && item.DoneAt == nil {
+			item.DoneBy = userID
+			item.DoneAt = &now
+		}
+	}
+}
+
+func (s *TaskService) Create(task *domain.Task) error {
+	task.Status = domain.TaskStatusPending
+	if err := s.validate(task); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	markChecklist(task, task.CreatedBy, now)
+	task.Comments = []domain.TaskComment{}
+	task.CompletedAt = nil
+	task.CreatedAt = now
+	task.UpdatedAt = now
+
+	return s.taskRepo.Create(task)
+}
+
+func (s *TaskService) GetByID(id string) (*domain.Task, error) {
+	return s.taskRepo.FindByID(id)
+}
+
+func (s *TaskService) List(query domain.TaskQuery) ([]*domain.Task, error) {
+	if query.Status != "" && !query.Status.IsValid() {
+		return nil, newValidationError("status da tarefa inválido: %s", query.Status)
+	}
+	if query.Priority != "" && !query.Priority.IsValid() {
+		return nil, newValidationError("prioridade inválida: %s", query.Priority)
+	}
+	return s.taskRepo.Find(query)
+}
+
+// GetMine lista as tarefas atribuídas ao usuário
+func (s *TaskService) GetMine(userID string, status domain.TaskStatus) ([]*domain.Task, error) {
+	assigneeID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, repositories.ErrInvalidID
+	}
+	return s.List(domain.TaskQuery{AssigneeIDs: []primitive.ObjectID{assigneeID}, Status: status})
+}
+
+// GetDelegated lista as tarefas que o supervisor atribuiu aos seus
+// subordinados (usuários com ProfessionalInfo.SupervisorID igual ao dele)
+func (s *TaskService) GetDelegated(supervisorID string, status domain.TaskStatus) ([]*domain.Task, error) {
+	subordinates, err := s.userRepo.FindBySupervisorID(supervisorID)
+	if err != nil {
+		return nil, err
+	}
+	if len(subordinates) == 0 {
+		return []*domain.Task{}, nil
+	}
+
+	assigneeIDs := make([]primitive.ObjectID, 0, len(subordinates))
+	for _, user := range subordinates {
+		assigneeIDs = append(assigneeIDs, user.ID)
+	}
+	return s.List(domain.TaskQuery{AssigneeIDs: assigneeIDs, CreatedBy: supervisorID, Status: status})
+}
+
+// GetOverdue lista as tarefas abertas com vencimento anterior a hoje. Sem
+// responsável informado, considera todas as tarefas.
+func (s *TaskService) GetOverdue(assigneeID string) ([]*domain.Task, error) {
+	query := domain.TaskQuery{DueBefore: calendar.Date(time.Now())}
+	if assigneeID != "" {
+		objectID, err := primitive.ObjectIDFromHex(assigneeID)
+		if err != nil {
+			return nil, repositories.ErrInvalidID
+		}
+		query.AssigneeIDs = []primitive.ObjectID{objectID}
+	}
+	return s.taskRepo.Find(query)
+}
+
+// Update altera a tarefa; os comentários são mantidos e só mudam via AddComment
+func (s *TaskService) Update(task *domain.Task, userID primitive.ObjectID) error {
+	existing, err := s.taskRepo.FindByID(task.ID.Hex())
+	if err != nil {
+		return err
+	}
+
+	if err := s.validate(task); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	markChecklist(task, userID, now)
+
+	switch {
+	case task.Status == domain.TaskStatusDone && existing.Status != domain.TaskStatusDone:
+		task.CompletedAt = &now
+	case task.Status != domain.TaskStatusDone:
+		task.CompletedAt = nil
+	}
+
+	// Preservar os dados de criação originais
+	task.Comments = existing.Comments
+	task.CreatedAt = existing.CreatedAt
+	task.CreatedBy = existing.CreatedBy
+	task.UpdatedAt = now
+
+	return s.taskRepo.Update(task)
+}
+
+// SetChecklistItem marca/desmarca ou renomeia um item do checklist
+func (s *TaskService) SetChecklistItem(taskID, itemID string, done *bool, text string, userID primitive.ObjectID) (*domain.Task, error) {
+	task, err := s.taskRepo.FindByID(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for i := range task.Checklist {
+		item := &task.Checklist[i]
+		if item.ID.Hex() != itemID {
+			continue
+		}
+		found = true
+		if text != "" {
+			item.Text = text
+		}
+		if done != nil && *done != item.Done {
+			item.Done = *done
+			item.DoneAt = nil
+		}
+	}
+	if !found {
+		return nil, ErrChecklistItemNotFound
+	}
+
+	if err := s.Update(task, userID); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func (s *TaskService) AddComment(taskID string, comment *domain.TaskComment) error {
+	comment.Text = strings.TrimSpace(comment.Text)
+	if comment.Text == "" {
+		return newValidationError("texto do comentário é obrigatório")
+	}
+	if len(comment.Text) > maxTaskCommentLength {
+		return newValidationError("comentário deve ter no máximo %d caracteres", maxTaskCommentLength)
+	}
+
+	comment.ID = primitive.NewObjectID()
+	comment.CreatedAt = time.Now()
+	return s.taskRepo.AddComment(taskID, *comment)
+}
+
+func (s *TaskService) Delete(id string) error {
+	return s.taskRepo.Delete(id)
+}
@@ -0,0 +1,404 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/calendar"
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrNoRunningTimer é retornado ao parar um cronômetro quando nenhum está em andamento
+	ErrNoRunningTimer = errors.New("nenhum cronômetro em andamento")
+
+	// ErrTimerRunning é retornado ao alterar a duração de um cronômetro ainda em andamento
+	ErrTimerRunning = errors.New("o cronômetro ainda está em andamento; pare-o antes de alterar a duração")
+
+	// ErrTimeEntryInvoiced é retornado ao alterar ou remover um lançamento já faturado
+	ErrTimeEntryInvoiced = errors.New("o lançamento já foi faturado; cancele a fatura para alterá-lo")
+)
+
+const (
+	// maxEntryMinutes limita a duração de um lançamento a um dia
+	maxEntryMinutes = 24 * 60
+
+	// maxEntryDescription limita o tamanho da descrição do lançamento
+	maxEntryDescription = 1000
+)
+
+type TimeEntryService struct {
+	entryRepo     domain.TimeEntryRepository
+	caseRepo      domain.CaseRepository
+	clientRepo    domain.ClientRepository
+	userRepo      domain.UserRepository
+	agreementRepo domain.FeeAgreementRepository
+}
+
+func NewTimeEntryService(entryRepo domain.TimeEntryRepository, caseRepo domain.CaseRepository, clientRepo domain.ClientRepository, userRepo domain.UserRepository, agreementRepo domain.FeeAgreementRepository) *TimeEntryService {
+	return &TimeEntryService{
+		entryRepo:     entryRepo,
+		caseRepo:      caseRepo,
+		clientRepo:    clientRepo,
+		userRepo:      userRepo,
+		agreementRepo: agreementRepo,
+	}
+}
+
+// validate confere o lançamento e, se resolveRate, define o valor da hora a
+// partir do contrato de honorários, do acordo com o cliente ou, na falta
+// deles, do valor do profissional
+func (s *TimeEntryService) validate(entry *domain.TimeEntry, resolveRate bool) error {
+	if entry.ActivityCode == "" {
+		entry.ActivityCode = domain.ActivityOther
+	}
+	if !entry.ActivityCode.IsValid() {
+		return newValidationError("código de atividade inválido: %s", entry.ActivityCode)
+	}
+	entry.Description = strings.TrimSpace(entry.Description)
+	if len(entry.Description) > maxEntryDescription {
+		return newValidationError("descrição deve ter no máximo %d caracteres", maxEntryDescription)
+	}
+
+	if entry.CaseID.IsZero() {
+		return newValidationError("processo é obrigatório")
+	}
+	case_, err := s.caseRepo.FindByID(entry.CaseID.Hex())
+	if err != nil {
+		if errors.Is(err, repositories.ErrCaseNotFound) {
+			return newValidationError("processo não encontrado")
+		}
+		return err
+	}
+	entry.ClientID = case_.ClientID
+
+	user, err := s.userRepo.FindByID(entry.UserID.Hex())
+	if err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return newValidationError("usuário não encontrado")
+		}
+		return err
+	}
+
+	if !entry.Running {
+		if err := validateDuration(entry); err != nil {
+			return err
+		}
+	}
+
+	if resolveRate {
+		if err := s.resolveRate(entry, user); err != nil {
+			return err
+		}
+	}
+	entry.AmountCents = entryAmount(entry)
+
+	return nil
+}
+
+// validateDuration calcula a duração a partir do início e fim ou aceita a
+// duração informada diretamente para o dia do lançamento
+func validateDuration(entry *domain.TimeEntry) error {
+	if entry.StartAt != nil || entry.EndAt != nil {
+		if entry.StartAt == nil || entry.EndAt == nil {
+			return newValidationError("informe início e fim, ou apenas a duração")
+		}
+		if !entry.EndAt.After(*entry.StartAt) {
+			return newValidationError("o fim deve ser posterior ao início")
+		}
+		entry.Minutes = durationMinutes(entry.EndAt.Sub(*entry.StartAt))
+		entry.Date = calendar.Date(*entry.StartAt)
+	}
+
+	if entry.Date.IsZero() {
+		return newValidationError("data do lançamento é obrigatória")
+	}
+	if entry.Minutes < 1 || entry.Minutes > maxEntryMinutes {
+		return newValidationError("a duração deve ser de 1 a %d minutos", maxEntryMinutes)
+	}
+	return nil
+}
+
+// durationMinutes arredonda a duração para cima, em minutos inteiros
+func durationMinutes(d time.Duration) int {
+	minutes := int((d + time.Minute - 1) / time.Minute)
+	return max(minutes, 1)
+}
+
+func (s *TimeEntryService) resolveRate(entry *domain.TimeEntry, user *domain.User) error {
+	agreements, err := s.agreementRepo.FindByClientID(entry.ClientID.Hex(), true)
+	if err != nil {
+		return err
+	}
+	if agreement := hourlyAgreement(agreements, entry.CaseID, entry.Date); agreement != nil {
+		entry.RateCents = agreement.HourlyRateCents
+		entry.RateSource = domain.RateSourceAgreement
+		return nil
+	}
+
+	client, err := s.clientRepo.FindByID(entry.ClientID.Hex())
+	if err != nil && !errors.Is(err, repositories.ErrClientNotFound) {
+		return err
+	}
+
+	switch {
+	case client != nil && client.HourlyRateCents > 0:
+		entry.RateCents = client.HourlyRateCents
+		entry.RateSource = domain.RateSourceClient
+	case user.ProfessionalInfo.HourlyRateCents > 0:
+		entry.RateCents = user.ProfessionalInfo.HourlyRateCents
+		entry.RateSource = domain.RateSourceUser
+	default:
+		entry.RateCents = 0
+		entry.RateSource = domain.RateSourceNone
+	}
+	return nil
+}
+
+// hourlyAgreement escolhe o contrato por hora vigente na data, preferindo o
+// contrato específico do processo ao contrato geral do cliente
+func hourlyAgreement(agreements []*domain.FeeAgreement, caseID primitive.ObjectID, date time.Time) *domain.FeeAgreement {
+	var selected *domain.FeeAgreement
+	for _, agreement := range agreements {
+		if agreement.Type != domain.FeeTypeHourly || !agreement.AppliesTo(caseID) {
+			continue
+		}
+		if date.Before(agreement.StartDate) || (agreement.EndDate != nil && date.After(*agreement.EndDate)) {
+			continue
+		}
+		if selected == nil || (selected.CaseID.IsZero() && !agreement.CaseID.IsZero()) {
+			selected = agreement
+		}
+	}
+	return selected
+}
+
+// entryAmount calcula o valor faturável, arredondado ao centavo mais próximo
+func entryAmount(entry *domain.TimeEntry) int64 {
+	if !entry.Billable || entry.Running {
+		return 0
+	}
+	return (entry.RateCents*int64(entry.Minutes) + 30) / 60
+}
+
+// Create registra um lançamento já concluído
+func (s *TimeEntryService) Create(entry *domain.TimeEntry) error {
+	entry.Running = false
+	if err := s.validate(entry, true); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	entry.CreatedAt = now
+	entry.UpdatedAt = now
+
+	return s.entryRepo.Create(entry)
+}
+
+func (s *TimeEntryService) GetByID(id string) (*domain.TimeEntry, error) {
+	return s.entryRepo.FindByID(id)
+}
+
+func (s *TimeEntryService) List(query domain.TimeEntryQuery) ([]*domain.TimeEntry, error) {
+	if !query.From.IsZero() && !query.To.IsZero() && !query.To.After(query.From) {
+		return nil, newValidationError("o fim do período deve ser posterior ao início")
+	}
+	return s.entryRepo.Find(query)
+}
+
+func (s *TimeEntryService) Update(entry *domain.TimeEntry) error {
+	existing, err := s.entryRepo.FindByID(entry.ID.Hex())
+	if err != nil {
+		return err
+	}
+
+	if !existing.InvoiceID.IsZero() {
+		return ErrTimeEntryInvoiced
+	}
+
+	entry.Running = existing.Running
+	entry.InvoiceID = existing.InvoiceID
+	if entry.Running && (entry.Minutes != existing.Minutes || !sameTime(entry.EndAt, existing.EndAt) || !sameTime(entry.StartAt, existing.StartAt)) {
+		return ErrTimerRunning
+	}
+
+	// O valor da hora é mantido, salvo se o lançamento mudar de processo ou de usuário
+	resolveRate := entry.CaseID != existing.CaseID || entry.UserID != existing.UserID
+	if err := s.validate(entry, resolveRate); err != nil {
+		return err
+	}
+
+	// Preservar os dados de criação originais
+	entry.CreatedAt = existing.CreatedAt
+	entry.UpdatedAt = time.Now()
+
+	return s.entryRepo.Update(entry)
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func (s *TimeEntryService) Delete(id string) error {
+	entry, err := s.entryRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if !entry.InvoiceID.IsZero() {
+		return ErrTimeEntryInvoiced
+	}
+	return s.entryRepo.Delete(id)
+}
+
+// StartTimer inicia um cronômetro para o usuário; só um pode estar em andamento
+func (s *TimeEntryService) StartTimer(entry *domain.TimeEntry) error {
+	if _, err := s.entryRepo.FindRunning(entry.UserID.Hex()); err == nil {
+		return repositories.ErrTimerAlreadyRunning
+	} else if !errors.Is(err, repositories.ErrTimeEntryNotFound) {
+		return err
+	}
+
+	now := time.Now()
+	entry.Running = true
+	entry.StartAt = &now
+	entry.EndAt = nil
+	entry.Minutes = 0
+	entry.Date = calendar.Date(now)
+	if err := s.validate(entry, true); err != nil {
+		return err
+	}
+
+	entry.CreatedAt = now
+	entry.UpdatedAt = now
+
+	return s.entryRepo.Create(entry)
+}
+
+// StopTimer encerra o cronômetro em andamento do usuário e calcula o valor
+func (s *TimeEntryService) StopTimer(userID string) (*domain.TimeEntry, error) {
+	entry, err := s.GetRunning(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	entry.Running = false
+	entry.EndAt = &now
+	entry.Minutes = min(durationMinutes(now.Sub(*entry.StartAt)), maxEntryMinutes)
+	entry.AmountCents = entryAmount(entry)
+	entry.UpdatedAt = now
+
+	if err := s.entryRepo.Update(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (s *TimeEntryService) GetRunning(userID string) (*domain.TimeEntry, error) {
+	entry, err := s.entryRepo.FindRunning(userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTimeEntryNotFound) {
+			return nil, ErrNoRunningTimer
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+// weekBounds retorna a segunda-feira da semana da data e a segunda seguinte
+func weekBounds(day time.Time) (time.Time, time.Time) {
+	date := calendar.Date(day)
+	offset := (int(date.Weekday()) + 6) % 7
+	start := date.AddDate(0, 0, -offset)
+	return start, start.AddDate(0, 0, 7)
+}
+
+// WeeklyByUser resume as horas do usuário na semana, por dia e por processo
+func (s *TimeEntryService) WeeklyByUser(userID string, week time.Time) (*domain.TimesheetSummary, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, repositories.ErrInvalidID
+	}
+
+	start, end := weekBounds(week)
+	entries, err := s.entryRepo.Find(domain.TimeEntryQuery{UserID: userID, From: start, To: end})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := summarizeWeek(start, entries, func(entry *domain.TimeEntry) primitive.ObjectID { return entry.CaseID })
+	summary.UserID = objectID
+	return summary, nil
+}
+
+// WeeklyByCase resume as horas lançadas no processo na semana, por dia e por usuário
+func (s *TimeEntryService) WeeklyByCase(caseID string, week time.Time) (*domain.TimesheetSummary, error) {
+	objectID, err := primitive.ObjectIDFromHex(caseID)
+	if err != nil {
+		return nil, repositories.ErrInvalidID
+	}
+
+	start, end := weekBounds(week)
+	entries, err := s.entryRepo.Find(domain.TimeEntryQuery{CaseID: caseID, From: start, To: end})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := summarizeWeek(start, entries, func(entry *domain.TimeEntry) primitive.ObjectID { return entry.UserID })
+	summary.CaseID = objectID
+	return summary, nil
+}
+
+// summarizeWeek totaliza os lançamentos concluídos da semana por dia e pela
+// chave informada; cronômetros em andamento ainda não contam
+func summarizeWeek(start time.Time, entries []*domain.TimeEntry, key func(*domain.TimeEntry) primitive.ObjectID) *domain.TimesheetSummary {
+	summary := &domain.TimesheetSummary{
+		WeekStart: start,
+		WeekEnd:   start.AddDate(0, 0, 6),
+		Days:      make([]domain.TimesheetDay, 7),
+		Breakdown: []domain.TimesheetBreakdown{},
+	}
+	for i := range summary.Days {
+		summary.Days[i].Date = start.AddDate(0, 0, i)
+	}
+
+	index := make(map[primitive.ObjectID]int)
+	for _, entry := range entries {
+		if entry.Running {
+			continue
+		}
+
+		billable := 0
+		if entry.Billable {
+			billable = entry.Minutes
+		}
+
+		summary.TotalMinutes += entry.Minutes
+		summary.BillableMinutes += billable
+		summary.AmountCents += entry.AmountCents
+
+		if day := int(entry.Date.Sub(start).Hours() / 24); day >= 0 && day < 7 {
+			summary.Days[day].Minutes += entry.Minutes
+			summary.Days[day].BillableMinutes += billable
+		}
+
+		id := key(entry)
+		i, ok := index[id]
+		if !ok {
+			i = len(summary.Breakdown)
+			index[id] = i
+			summary.Breakdown = append(summary.Breakdown, domain.TimesheetBreakdown{ID: id})
+		}
+		summary.Breakdown[i].Minutes += entry.Minutes
+		summary.Breakdown[i].BillableMinutes += billable
+		summary.Breakdown[i].AmountCents += entry.AmountCents
+	}
+
+	return summary
+}
@@ -0,0 +1,352 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrTrashPurgeDisabled é retornado quando a retenção da lixeira não foi configurada
+	ErrTrashPurgeDisabled = errors.New("a remoção automática da lixeira está desativada")
+
+	// ErrRecordHasReferences é retornado ao excluir um processo ou cliente com
+	// lançamentos de faturamento ou processos vinculados
+	ErrRecordHasReferences = errors.New("existem processos ou registros de faturamento vinculados; a exclusão não é permitida")
+)
+
+// RecordReferencesError detalha, por coleção, os registros que impedem a
+// exclusão do processo ou cliente
+type RecordReferencesError struct {
+	References map[string]int64
+}
+
+func (e *RecordReferencesError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrRecordHasReferences, formatReferences(e.References))
+}
+
+func (e *RecordReferencesError) Unwrap() error {
+	return ErrRecordHasReferences
+}
+
+// checkRecordReferences retorna RecordReferencesError se a contagem encontrar
+// registros vinculados
+func checkRecordReferences(count func(id string) (map[string]int64, error), id string) error {
+	references, err := count(id)
+	if err != nil {
+		return err
+	}
+	if len(references) > 0 {
+		return &RecordReferencesError{References: references}
+	}
+	return nil
+}
+
+// TrashService lista, restaura e remove definitivamente os usuários,
+// processos, clientes e documentos excluídos
+type TrashService struct {
+	userRepo      domain.UserRepository
+	caseRepo      domain.CaseRepository
+	clientRepo    domain.ClientRepository
+	documentRepo  domain.DocumentRepository
+	referenceRepo domain.UserReferenceRepository
+	recordRefs    domain.RecordReferenceRepository
+	documents     *DocumentService
+	retention     time.Duration
+}
+
+func NewTrashService(userRepo domain.UserRepository, caseRepo domain.CaseRepository, clientRepo domain.ClientRepository, documentRepo domain.DocumentRepository, referenceRepo domain.UserReferenceRepository, recordRefs domain.RecordReferenceRepository, documents *DocumentService, retention time.Duration) *TrashService {
+	return &TrashService{
+		userRepo:      userRepo,
+		caseRepo:      caseRepo,
+		clientRepo:    clientRepo,
+		documentRepo:  documentRepo,
+		referenceRepo: referenceRepo,
+		recordRefs:    recordRefs,
+		documents:     documents,
+		retention:     retention,
+	}
+}
+
+func validateTrashKind(kind domain.TrashKind) error {
+	if !kind.IsValid() {
+		return newValidationError("tipo de registro inválido na lixeira: %s (use users, cases, clients ou documents)", kind)
+	}
+	return nil
+}
+
+// List lista a lixeira, dos registros excluídos mais recentemente aos mais
+// antigos; sem tipo, lista todos os tipos
+func (s *TrashService) List(kind domain.TrashKind) ([]*domain.TrashItem, error) {
+	kinds := domain.TrashKinds
+	if kind != "" {
+		if err := validateTrashKind(kind); err != nil {
+			return nil, err
+		}
+		kinds = []domain.TrashKind{kind}
+	}
+
+	items := []*domain.TrashItem{}
+	for _, kind := range kinds {
+		found, err := s.items(kind, time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, found...)
+	}
+
+	slices.SortStableFunc(items, func(a, b *domain.TrashItem) int {
+		return b.DeletedAt.Compare(a.DeletedAt)
+	})
+	return items, nil
+}
+
+// items carrega os registros do tipo na lixeira; deletedBefore, se
+// informado, limita aos excluídos antes dele
+func (s *TrashService) items(kind domain.TrashKind, deletedBefore time.Time) ([]*domain.TrashItem, error) {
+	items := []*domain.TrashItem{}
+	switch kind {
+	case domain.TrashKindUser:
+		users, err := s.userRepo.FindDeleted(deletedBefore)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			items = append(items, s.item(kind, user.ID, user.PersonalInfo.Name, user.DeletedAt, user.DeletedBy))
+		}
+	case domain.TrashKindCase:
+		cases, err := s.caseRepo.FindDeleted(deletedBefore)
+		if err != nil {
+			return nil, err
+		}
+		for _, case_ := range cases {
+			items = append(items, s.item(kind, case_.ID, caseTitle(case_), case_.DeletedAt, case_.DeletedBy))
+		}
+	case domain.TrashKindClient:
+		clients, err := s.clientRepo.FindDeleted(deletedBefore)
+		if err != nil {
+			return nil, err
+		}
+		for _, client := range clients {
+			items = append(items, s.item(kind, client.ID, client.Name, client.DeletedAt, client.DeletedBy))
+		}
+	case domain.TrashKindDocument:
+		documents, err := s.documentRepo.FindDeleted(deletedBefore)
+		if err != nil {
+			return nil, err
+		}
+		for _, document := range documents {
+			items = append(items, s.item(kind, document.ID, document.Title, document.DeletedAt, document.DeletedBy))
+		}
+	}
+	return items, nil
+}
+
+func (s *TrashService) item(kind domain.TrashKind, id primitive.ObjectID, title string, deletedAt *time.Time, deletedBy primitive.ObjectID) *domain.TrashItem {
+	item := &domain.TrashItem{Kind: kind, ID: id, Title: title, DeletedBy: deletedBy}
+	if deletedAt != nil {
+		item.DeletedAt = *deletedAt
+	}
+	if s.retention > 0 {
+		purgeAt := item.DeletedAt.Add(s.retention)
+		item.PurgeAt = &purgeAt
+	}
+	return item
+}
+
+// caseTitle identifica o processo pelo número CNJ, quando houver, e pelo título
+func caseTitle(case_ *domain.Case) string {
+	if case_.Number == "" {
+		return case_.Title
+	}
+	return case_.Number + " - " + case_.Title
+}
+
+// Restore devolve o registro às buscas. Processos e documentos só podem ser
+// restaurados se o cliente ou processo a que pertencem não estiver excluído.
+func (s *TrashService) Restore(kind domain.TrashKind, id string) error {
+	if err := validateTrashKind(kind); err != nil {
+		return err
+	}
+
+	switch kind {
+	case domain.TrashKindUser:
+		user, err := s.userRepo.FindDeletedByID(id)
+		if err != nil {
+			return err
+		}
+		// Outro usuário pode ter sido cadastrado com o mesmo email após a exclusão
+		if _, err := s.userRepo.FindByEmail(user.PersonalInfo.Email); err == nil {
+			return repositories.ErrDuplicateEmail
+		} else if !errors.Is(err, repositories.ErrUserNotFound) {
+			return err
+		}
+		return s.userRepo.Restore(id)
+	case domain.TrashKindCase:
+		case_, err := s.caseRepo.FindDeletedByID(id)
+		if err != nil {
+			return err
+		}
+		if _, err := s.clientRepo.FindByID(case_.ClientID.Hex()); err != nil {
+			if errors.Is(err, repositories.ErrClientNotFound) {
+				return newValidationError("o cliente do processo foi excluído; restaure-o antes do processo")
+			}
+			return err
+		}
+		// O número CNJ pode ter sido cadastrado em outro processo após a exclusão
+		if case_.Number != "" {
+			if _, err := s.caseRepo.FindByNumber(case_.Number); err == nil {
+				return repositories.ErrDuplicateCaseNumber
+			} else if !errors.Is(err, repositories.ErrCaseNotFound) {
+				return err
+			}
+		}
+		return s.caseRepo.Restore(id)
+	case domain.TrashKindClient:
+		client, err := s.clientRepo.FindDeletedByID(id)
+		if err != nil {
+			return err
+		}
+		if _, err := s.clientRepo.FindByDocument(client.Document); err == nil {
+			return repositories.ErrDuplicateClientDocument
+		} else if !errors.Is(err, repositories.ErrClientNotFound) {
+			return err
+		}
+		return s.clientRepo.Restore(id)
+	default:
+		document, err := s.documentRepo.FindDeletedByID(id)
+		if err != nil {
+			return err
+		}
+		if _, err := s.caseRepo.FindByID(document.CaseID.Hex()); err != nil {
+			if errors.Is(err, repositories.ErrCaseNotFound) {
+				return newValidationError("o processo do documento foi excluído; restaure-o antes do documento")
+			}
+			return err
+		}
+		return s.documentRepo.Restore(id)
+	}
+}
+
+// Purge remove definitivamente um registro da lixeira, antes do fim da retenção
+func (s *TrashService) Purge(kind domain.TrashKind, id string) error {
+	if err := validateTrashKind(kind); err != nil {
+		return err
+	}
+
+	var err error
+	switch kind {
+	case domain.TrashKindUser:
+		_, err = s.userRepo.FindDeletedByID(id)
+	case domain.TrashKindCase:
+		_, err = s.caseRepo.FindDeletedByID(id)
+	case domain.TrashKindClient:
+		_, err = s.clientRepo.FindDeletedByID(id)
+	default:
+		_, err = s.documentRepo.FindDeletedByID(id)
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.purge(kind, id)
+}
+
+func (s *TrashService) purge(kind domain.TrashKind, id string) error {
+	switch kind {
+	case domain.TrashKindUser:
+		// Usuários só vão para a lixeira sem registros vinculados; conferir
+		// de novo para não deixar referências órfãs
+		references, err := s.referenceRepo.CountReferences(id)
+		if err != nil {
+			return err
+		}
+		if len(references) > 0 {
+			return &UserReferencesError{References: references}
+		}
+		return s.userRepo.Purge(id)
+	case domain.TrashKindCase:
+		// Faturas e lançamentos não podem apontar para um processo removido;
+		// conferir de novo, pois podem ter sido vinculados após a exclusão
+		if err := checkRecordReferences(s.recordRefs.CountCaseReferences, id); err != nil {
+			return err
+		}
+		// Documentos, prazos, compromissos e tarefas do processo não fazem sentido sem ele
+		documents, err := s.documentRepo.FindByCaseID(id)
+		if err != nil {
+			return err
+		}
+		for _, document := range documents {
+			if err := s.documents.Purge(document.ID.Hex()); err != nil {
+				return err
+			}
+		}
+		if err := s.recordRefs.PurgeCaseRecords(id); err != nil {
+			return err
+		}
+		return s.caseRepo.Purge(id)
+	case domain.TrashKindClient:
+		if err := checkRecordReferences(s.recordRefs.CountClientReferences, id); err != nil {
+			return err
+		}
+		return s.clientRepo.Purge(id)
+	default:
+		return s.documents.Purge(id)
+	}
+}
+
+// PurgeExpired remove definitivamente os registros que estão na lixeira há
+// mais tempo que a retenção configurada. Falhas em um registro não
+// interrompem a remoção dos demais.
+func (s *TrashService) PurgeExpired() (*domain.TrashPurge, error) {
+	if s.retention <= 0 {
+		return nil, ErrTrashPurgeDisabled
+	}
+
+	result := &domain.TrashPurge{
+		Before:  time.Now().Add(-s.retention),
+		Removed: make(map[domain.TrashKind]int),
+	}
+	for _, kind := range domain.TrashKinds {
+		items, err := s.items(kind, result.Before)
+		if err != nil {
+			return result, err
+		}
+		for _, item := range items {
+			if err := s.purge(kind, item.ID.Hex()); err != nil {
+				log.Printf("Aviso: falha ao remover definitivamente %s %s da lixeira: %v", kind, item.ID.Hex(), err)
+				continue
+			}
+			result.Removed[kind]++
+		}
+	}
+	return result, nil
+}
+
+// RunPurge executa PurgeExpired a cada intervalo, até o contexto ser encerrado
+func (s *TrashService) RunPurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := s.PurgeExpired()
+		if err != nil {
+			log.Printf("Aviso: falha na limpeza da lixeira: %v", err)
+		} else if total := result.Total(); total > 0 {
+			log.Printf("Lixeira: %d registro(s) removido(s) definitivamente", total)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/domain"
+	"github.com/jurisconnect/backend/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrUserInactive é retornado quando um usuário desativado tenta acessar o sistema
+	ErrUserInactive = errors.New("usuário desativado")
+
+	// ErrUserAlreadyInactive é retornado ao desativar um usuário já desativado
+	ErrUserAlreadyInactive = errors.New("o usuário já está desativado")
+
+	// ErrUserAlreadyActive é retornado ao reativar um usuário ativo
+	ErrUserAlreadyActive = errors.New("o usuário já está ativo")
+
+	// ErrSelfDeactivation é retornado quando o usuário tenta desativar ou remover a si mesmo
+	ErrSelfDeactivation = errors.New("não é possível desativar ou remover o próprio usuário")
+
+	// ErrLastAdmin é retornado ao desativar ou remover o último administrador ativo
+	ErrLastAdmin = errors.New("o sistema precisa de ao menos um administrador ativo")
+
+	// ErrUserHasReferences é retornado ao excluir um usuário com histórico
+	ErrUserHasReferences = errors.New("o usuário possui registros vinculados; desative-o em vez de removê-lo")
+)
+
+// UserReferencesError detalha, por coleção, os registros que impedem a exclusão
+type UserReferencesError struct {
+	References map[string]int64
+}
+
+func (e *UserReferencesError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrUserHasReferences, formatReferences(e.References))
+}
+
+// formatReferences lista as coleções com registros vinculados, em ordem alfabética
+func formatReferences(references map[string]int64) string {
+	collections := make([]string, 0, len(references))
+	for name, count := range references {
+		collections = append(collections, fmt.Sprintf("%s (%d)", name, count))
+	}
+	sort.Strings(collections)
+	return strings.Join(collections, ", ")
+}
+
+func (e *UserReferencesError) Unwrap() error {
+	return ErrUserHasReferences
+}
+
+// UserLifecycleService cuida da saída de usuários do escritório: desativação,
+// reativação, transferência do trabalho em aberto e exclusão definitiva
+type UserLifecycleService struct {
+	userRepo      domain.UserRepository
+	referenceRepo domain.UserReferenceRepository
+	caseRepo      domain.CaseRepository
+	taskRepo      domain.TaskRepository
+	deadlineRepo  domain.DeadlineRepository
+	feedRepo      domain.CalendarFeedRepository
+	authService   *AuthService
+}
+
+func NewUserLifecycleService(userRepo domain.UserRepository, referenceRepo domain.UserReferenceRepository, caseRepo domain.CaseRepository, taskRepo domain.TaskRepository, deadlineRepo domain.DeadlineRepository, feedRepo domain.CalendarFeedRepository, authService *AuthService) *UserLifecycleService {
+	return &UserLifecycleService{
+		userRepo:      userRepo,
+		referenceRepo: referenceRepo,
+		caseRepo:      caseRepo,
+		taskRepo:      taskRepo,
+		deadlineRepo:  deadlineRepo,
+		feedRepo:      feedRepo,
+		authService:   authService,
+	}
+}
+
+// ensureNotLastAdmin impede que o último administrador ativo saia do sistema
+func (s *UserLifecycleService) ensureNotLastAdmin(user *domain.User) error {
+	if user.Role != domain.RoleAdmin.Name || !user.IsActive {
+		return nil
+	}
+	count, err := s.userRepo.CountActiveByRole(domain.RoleAdmin.Name)
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return ErrLastAdmin
+	}
+	return nil
+}
+
+// Deactivate bloqueia o acesso do usuário, encerrando suas sessões e
+// assinaturas de agenda. Com reassignTo, os processos, tarefas e prazos em
+// aberto são transferidos antes da desativação.
+func (s *UserLifecycleService) Deactivate(id string, by *domain.User, reassignTo string) (*domain.User, *domain.UserReassignment, error) {
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !user.IsActive {
+		return nil, nil, ErrUserAlreadyInactive
+	}
+	if user.ID == by.ID {
+		return nil, nil, ErrSelfDeactivation
+	}
+	if err := s.ensureNotLastAdmin(user); err != nil {
+		return nil, nil, err
+	}
+
+	var reassignment *domain.UserReassignment
+	if reassignTo != "" {
+		if reassignment, err = s.Reassign(id, reassignTo); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	now := time.Now()
+	user.IsActive = false
+	user.DeactivatedAt = &now
+	user.DeactivatedBy = by.ID
+	user.UpdatedAt = now
+	if err := s.userRepo.Update(user); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.authService.LogoutAll(id); err != nil {
+		return nil, nil, err
+	}
+	if err := s.feedRepo.RevokeAllByUser(id); err != nil {
+		return nil, nil, err
+	}
+
+	return user, reassignment, nil
+}
+
+// Reactivate devolve o acesso ao usuário; sessões e assinaturas revogadas
+// na desativação não voltam a valer
+func (s *UserLifecycleService) Reactivate(id string) (*domain.User, error) {
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsActive {
+		return nil, ErrUserAlreadyActive
+	}
+
+	user.IsActive = true
+	user.DeactivatedAt = nil
+	user.DeactivatedBy = primitive.NilObjectID
+	user.UpdatedAt = time.Now()
+	if err := s.userRepo.Update(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// Reassign transfere para toID os processos em curso em que fromID é
+// responsável ou integrante da equipe, as tarefas abertas e os prazos pendentes
+func (s *UserLifecycleService) Reassign(fromID, toID string) (*domain.UserReassignment, error) {
+	from, err := s.userRepo.FindByID(fromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.userRepo.FindByID(toID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) || errors.Is(err, repositories.ErrInvalidID) {
+			return nil, newValidationError("usuário de destino não encontrado")
+		}
+		return nil, err
+	}
+	if from.ID == to.ID {
+		return nil, newValidationError("o usuário de destino deve ser diferente do usuário de origem")
+	}
+	if !to.IsActive {
+		return nil, newValidationError("o usuário de destino está desativado")
+	}
+
+	result := &domain.UserReassignment{FromUserID: from.ID, ToUserID: to.ID}
+	now := time.Now()
+
+	cases, err := s.caseRepo.FindByTeamMember(fromID)
+	if err != nil {
+		return nil, err
+	}
+	// Processos antigos podem ter o responsável fora da equipe
+	led, err := s.caseRepo.FindByLawyerID(fromID)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[primitive.ObjectID]bool)
+	for _, case_ := range append(cases, led...) {
+		if seen[case_.ID] || !case_.Status.IsOpen() {
+			continue
+		}
+		seen[case_.ID] = true
+		reassignTeam(case_, from.ID, to.ID, now)
+		case_.UpdatedAt = now
+		if err := s.caseRepo.Update(case_); err != nil {
+			return nil, err
+		}
+		result.Cases++
+	}
+
+	tasks, err := s.taskRepo.Find(domain.TaskQuery{AssigneeIDs: []primitive.ObjectID{from.ID}})
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		if !task.Status.IsOpen() {
+			continue
+		}
+		task.AssigneeID = to.ID
+		task.UpdatedAt = now
+		if err := s.taskRepo.Update(task); err != nil {
+			return nil, err
+		}
+		result.Tasks++
+	}
+
+	deadlines, err := s.deadlineRepo.FindByAssignee(fromID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	for _, deadline := range deadlines {
+		if deadline.Status != domain.DeadlineStatusPending {
+			continue
+		}
+		deadline.AssignedTo = to.ID
+		deadline.UpdatedAt = now
+		if err := s.deadlineRepo.Update(deadline); err != nil {
+			return nil, err
+		}
+		result.Deadlines++
+	}
+
+	return result, nil
+}
+
+// reassignTeam troca o integrante na equipe do processo, preservando seu
+// papel. Se o destino já estava na equipe, fica com o papel mais relevante.
+func reassignTeam(case_ *domain.Case, from, to primitive.ObjectID, now time.Time) {
+	if case_.LawyerID == from {
+		case_.LawyerID = to
+	}
+
+	var fromRole domain.CaseTeamRole
+	team := make([]domain.CaseTeamMember, 0, len(case_.Team))
+	for _, member := range case_.Team {
+		if member.UserID == from {
+			fromRole = member.Role
+			continue
+		}
+		team = append(team, member)
+	}
+
+	for i := range team {
+		if team[i].UserID == to {
+			if fromRole == domain.CaseTeamRoleResponsible || case_.LawyerID == to {
+				team[i].Role = domain.CaseTeamRoleResponsible
+			}
+			case_.Team = team
+			return
+		}
+	}
+
+	if case_.LawyerID == to {
+		fromRole = domain.CaseTeamRoleResponsible
+	}
+	if fromRole != "" {
+		team = append(team, domain.CaseTeamMember{UserID: to, Role: fromRole, AssignedAt: now})
+	}
+	case_.Team = team
+}
+
+// Delete move para a lixeira o usuário que nunca teve registros vinculados
+// (cadastro feito por engano); nos demais casos o usuário deve ser desativado
+func (s *UserLifecycleService) Delete(id string, by *domain.User) error {
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if user.ID == by.ID {
+		return ErrSelfDeactivation
+	}
+	if err := s.ensureNotLastAdmin(user); err != nil {
+		return err
+	}
+
+	references, err := s.referenceRepo.CountReferences(id)
+	if err != nil {
+		return err
+	}
+	if len(references) > 0 {
+		return &UserReferencesError{References: references}
+	}
+
+	if err := s.authService.LogoutAll(id); err != nil {
+		return err
+	}
+	if err := s.feedRepo.RevokeAllByUser(id); err != nil {
+		return err
+	}
+	return s.userRepo.Delete(id, by.ID)
+}
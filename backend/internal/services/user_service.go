@@ -12,10 +12,22 @@ import (
 
 type UserService struct {
 	userRepo domain.UserRepository
+	roleRepo domain.RoleRepository
 }
 
-func NewUserService(userRepo domain.UserRepository) *UserService {
-	return &UserService{userRepo: userRepo}
+func NewUserService(userRepo domain.UserRepository, roleRepo domain.RoleRepository) *UserService {
+	return &UserService{userRepo: userRepo, roleRepo: roleRepo}
+}
+
+// validateRole verifica se a role existe entre as roles cadastradas
+func (s *UserService) validateRole(role string) error {
+	if _, err := s.roleRepo.FindByName(role); err != nil {
+		if errors.Is(err, repositories.ErrRoleNotFound) {
+			return ErrInvalidRole
+		}
+		return err
+	}
+	return nil
 }
 
 func (s *UserService) validatePhone(phone string) error {
@@ -32,6 +44,12 @@ func (s *UserService) validatePhone(phone string) error {
 }
 
 func (s *UserService) Create(user *domain.User) error {
+	// Validar role
+	user.Role = NormalizeRoleName(user.Role)
+	if err := s.validateRole(user.Role); err != nil {
+		return err
+	}
+
 	// Validar força da senha
 	if err := security.ValidatePasswordStrength(user.Password); err != nil {
 		return err
@@ -153,10 +171,6 @@ func (s *UserService) Update(user *domain.User) error {
 	return s.userRepo.Update(user)
 }
 
-func (s *UserService) Delete(id string) error {
-	return s.userRepo.Delete(id)
-}
-
 func (s *UserService) UpdateLastLogin(id string) error {
 	return s.userRepo.UpdateLastLogin(id)
 }
@@ -167,17 +181,18 @@ func (s *UserService) HasPermission(userID string, module string, action string)
 		return false, err
 	}
 
-	// Verificar permissões baseadas no papel
-	switch user.Role {
-	case "admin":
-		return true, nil
-	case "lawyer":
-		return module == "cases" || module == "documents", nil
-	case "intern":
-		return module == "cases" && action == "read", nil
-	case "secretary":
-		return module == "cases" && action == "read", nil
-	default:
-		return false, nil
+	return s.RoleHasPermission(user.Role, module, action)
+}
+
+// RoleHasPermission avalia as permissões cadastradas para a role
+func (s *UserService) RoleHasPermission(roleName string, module string, action string) (bool, error) {
+	role, err := s.roleRepo.FindByName(roleName)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRoleNotFound) {
+			return false, nil
+		}
+		return false, err
 	}
+
+	return role.Can(module, action), nil
 }
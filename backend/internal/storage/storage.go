@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/jurisconnect/backend/internal/config"
+)
+
+// ErrObjectNotFound é retornado quando o arquivo não existe no armazenamento
+var ErrObjectNotFound = errors.New("arquivo não encontrado no armazenamento")
+
+// Storage abstrai o local onde os arquivos dos documentos são guardados.
+// As chaves usam "/" como separador, independentemente do backend.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// New cria o backend de armazenamento configurado em STORAGE_DRIVER
+func New(cfg *config.Config) (Storage, error) {
+	switch cfg.Storage.Driver {
+	case "local":
+		return NewLocalStorage(cfg.Storage.LocalPath)
+	case "s3":
+		return NewS3Storage(cfg.Storage.S3)
+	default:
+		return nil, fmt.Errorf("driver de armazenamento desconhecido: %s", cfg.Storage.Driver)
+	}
+}
@@ -0,0 +1,130 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jurisconnect/backend/internal/domain"
+)
+
+// ErrInvalidCNJNumber é retornado quando o número do processo não segue o padrão CNJ
+var ErrInvalidCNJNumber = errors.New("número de processo CNJ inválido")
+
+// cnjSegments mapeia o dígito J (segmento do Judiciário) para sua descrição
+var cnjSegments = map[int]string{
+	1: "Supremo Tribunal Federal",
+	2: "Conselho Nacional de Justiça",
+	3: "Superior Tribunal de Justiça",
+	4: "Justiça Federal",
+	5: "Justiça do Trabalho",
+	6: "Justiça Eleitoral",
+	7: "Justiça Militar da União",
+	8: "Justiça dos Estados e do Distrito Federal",
+	9: "Justiça Militar Estadual",
+}
+
+// ParseCNJNumber valida o número único de processo (NNNNNNN-DD.AAAA.J.TR.OOOO),
+// conforme a Resolução CNJ nº 65/2008, e extrai seus componentes. Aceita o
+// número formatado ou apenas com os 20 dígitos.
+func ParseCNJNumber(number string) (*domain.CNJInfo, error) {
+	digits := OnlyDigits(number)
+	if len(digits) != 20 {
+		return nil, fmt.Errorf("%w: são esperados 20 dígitos", ErrInvalidCNJNumber)
+	}
+
+	// Se houver formatação, ela deve corresponder à máscara oficial
+	if digits != strings.TrimSpace(number) && FormatCNJNumber(digits) != strings.TrimSpace(number) {
+		return nil, fmt.Errorf("%w: formato esperado NNNNNNN-DD.AAAA.J.TR.OOOO", ErrInvalidCNJNumber)
+	}
+
+	sequential := digits[0:7]
+	checkDigits := digits[7:9]
+	year := digits[9:13]
+	segment := digits[13:14]
+	court := digits[14:16]
+	origin := digits[16:20]
+
+	if expected := cnjCheckDigits(sequential, year, segment, court, origin); expected != checkDigits {
+		return nil, fmt.Errorf("%w: dígito verificador não confere", ErrInvalidCNJNumber)
+	}
+
+	yearValue, _ := strconv.Atoi(year)
+	if yearValue > time.Now().Year() {
+		return nil, fmt.Errorf("%w: ano de ajuizamento no futuro", ErrInvalidCNJNumber)
+	}
+
+	segmentValue, _ := strconv.Atoi(segment)
+	segmentName, ok := cnjSegments[segmentValue]
+	if !ok {
+		return nil, fmt.Errorf("%w: segmento do Judiciário desconhecido", ErrInvalidCNJNumber)
+	}
+
+	return &domain.CNJInfo{
+		Sequential:  sequential,
+		CheckDigits: checkDigits,
+		Year:        yearValue,
+		Segment:     segmentValue,
+		SegmentName: segmentName,
+		Court:       court,
+		Origin:      origin,
+	}, nil
+}
+
+// FormatCNJNumber aplica a máscara NNNNNNN-DD.AAAA.J.TR.OOOO a 20 dígitos
+func FormatCNJNumber(digits string) string {
+	if len(digits) != 20 {
+		return digits
+	}
+	return fmt.Sprintf("%s-%s.%s.%s.%s.%s",
+		digits[0:7], digits[7:9], digits[9:13], digits[13:14], digits[14:16], digits[16:20])
+}
+
+// NormalizeCNJNumber retorna o número no formato canônico com máscara
+func NormalizeCNJNumber(number string) string {
+	return FormatCNJNumber(OnlyDigits(number))
+}
+
+// cnjCheckDigits calcula os dígitos verificadores pelo módulo 97 (ISO 7064),
+// em etapas para não exceder a capacidade de um inteiro de 64 bits
+func cnjCheckDigits(sequential, year, segment, court, origin string) string {
+	remainder := mod97(sequential)
+	remainder = mod97(fmt.Sprintf("%02d%s%s%s", remainder, year, segment, court))
+	remainder = mod97(fmt.Sprintf("%02d%s00", remainder, origin))
+	return fmt.Sprintf("%02d", 98-remainder)
+}
+
+func mod97(digits string) int {
+	remainder := 0
+	for _, r := range digits {
+		remainder = (remainder*10 + int(r-'0')) % 97
+	}
+	return remainder
+}
+
+// cnjStateCourts mapeia o código TR dos tribunais estaduais (J = 8 ou 9) para a UF
+var cnjStateCourts = map[string]string{
+	"01": "AC", "02": "AL", "03": "AP", "04": "AM", "05": "BA", "06": "CE", "07": "DF",
+	"08": "ES", "09": "GO", "10": "MA", "11": "MT", "12": "MS", "13": "MG", "14": "PA",
+	"15": "PB", "16": "PR", "17": "PE", "18": "PI", "19": "RJ", "20": "RN", "21": "RS",
+	"22": "RO", "23": "RR", "24": "SC", "25": "SE", "26": "SP", "27": "TO",
+}
+
+// CNJCourtCode identifica o órgão de origem no formato "J.TR.OOOO" (ex.: "8.26.0100")
+func CNJCourtCode(info *domain.CNJInfo) string {
+	if info == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d.%s.%s", info.Segment, info.Court, info.Origin)
+}
+
+// CNJState retorna a UF do tribunal para a Justiça Estadual; nos demais
+// segmentos o tribunal pode abranger vários estados e a UF fica vazia
+func CNJState(info *domain.CNJInfo) string {
+	if info == nil || (info.Segment != 8 && info.Segment != 9) {
+		return ""
+	}
+	return cnjStateCourts[info.Court]
+}
@@ -0,0 +1,91 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseCNJNumber(t *testing.T) {
+	tests := []struct {
+		number  string
+		segment string
+		court   string
+		origin  string
+	}{
+		// Números publicados nos diários do TJAL e do TJSP
+		{"0710802-55.2018.8.02.0001", "Justiça dos Estados e do Distrito Federal", "02", "0001"},
+		{"0001327-64.2018.8.26.0158", "Justiça dos Estados e do Distrito Federal", "26", "0158"},
+		{"00013276420188260158", "Justiça dos Estados e do Distrito Federal", "26", "0158"},
+		{"0000123-11.2020.4.03.6100", "Justiça Federal", "03", "6100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.number, func(t *testing.T) {
+			info, err := ParseCNJNumber(tt.number)
+			if err != nil {
+				t.Fatalf("ParseCNJNumber(%q): %v", tt.number, err)
+			}
+			if info.SegmentName != tt.segment {
+				t.Errorf("SegmentName = %q, esperado %q", info.SegmentName, tt.segment)
+			}
+			if info.Court != tt.court || info.Origin != tt.origin {
+				t.Errorf("tribunal %s e origem %s, esperado %s e %s", info.Court, info.Origin, tt.court, tt.origin)
+			}
+		})
+	}
+}
+
+func TestParseCNJNumberInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		number string
+	}{
+		{"dígito verificador", "0710802-56.2018.8.02.0001"},
+		{"sequencial alterado", "0710803-55.2018.8.02.0001"},
+		{"máscara", "0710802.55.2018.8.02.0001"},
+		{"tamanho", "0710802-55.2018.8.02.001"},
+		{"ano futuro", "0000123-24.2099.8.26.0100"},
+		{"segmento", "0000123-92.2020.0.00.0000"},
+		{"vazio", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseCNJNumber(tt.number); !errors.Is(err, ErrInvalidCNJNumber) {
+				t.Errorf("ParseCNJNumber(%q) = %v, esperado ErrInvalidCNJNumber", tt.number, err)
+			}
+		})
+	}
+}
+
+func TestNormalizeCNJNumber(t *testing.T) {
+	if got := NormalizeCNJNumber(" 07108025520188020001 "); got != "0710802-55.2018.8.02.0001" {
+		t.Errorf("NormalizeCNJNumber = %q", got)
+	}
+}
+
+func TestCNJJurisdiction(t *testing.T) {
+	tests := []struct {
+		number string
+		court  string
+		state  string
+	}{
+		{"0710802-55.2018.8.02.0001", "8.02.0001", "AL"},
+		{"0001327-64.2018.8.26.0158", "8.26.0158", "SP"},
+		// Justiça Federal: o TR é a região, sem UF
+		{"0000123-11.2020.4.03.6100", "4.03.6100", ""},
+	}
+
+	for _, tt := range tests {
+		info, err := ParseCNJNumber(tt.number)
+		if err != nil {
+			t.Fatalf("ParseCNJNumber(%q): %v", tt.number, err)
+		}
+		if got := CNJCourtCode(info); got != tt.court {
+			t.Errorf("CNJCourtCode(%s) = %q, esperado %q", tt.number, got, tt.court)
+		}
+		if got := CNJState(info); got != tt.state {
+			t.Errorf("CNJState(%s) = %q, esperado %q", tt.number, got, tt.state)
+		}
+	}
+}
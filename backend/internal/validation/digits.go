@@ -0,0 +1,14 @@
+package validation
+
+import "strings"
+
+// OnlyDigits remove da string todos os caracteres que não são dígitos
+func OnlyDigits(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,94 @@
+package validation
+
+import "fmt"
+
+// IsValidCPF verifica os dígitos verificadores do CPF (aceita com ou sem máscara)
+func IsValidCPF(cpf string) bool {
+	digits := OnlyDigits(cpf)
+	if len(digits) != 11 || allSameDigit(digits) {
+		return false
+	}
+
+	for _, position := range []int{9, 10} {
+		sum := 0
+		for i := 0; i < position; i++ {
+			sum += int(digits[i]-'0') * (position + 1 - i)
+		}
+		check := (sum * 10) % 11
+		if check == 10 {
+			check = 0
+		}
+		if check != int(digits[position]-'0') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsValidCNPJ verifica os dígitos verificadores do CNPJ (aceita com ou sem máscara)
+func IsValidCNPJ(cnpj string) bool {
+	digits := OnlyDigits(cnpj)
+	if len(digits) != 14 || allSameDigit(digits) {
+		return false
+	}
+
+	weights := []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	for _, position := range []int{12, 13} {
+		sum := 0
+		offset := len(weights) - position
+		for i := 0; i < position; i++ {
+			sum += int(digits[i]-'0') * weights[offset+i]
+		}
+		check := sum % 11
+		if check < 2 {
+			check = 0
+		} else {
+			check = 11 - check
+		}
+		if check != int(digits[position]-'0') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsValidCPFOrCNPJ aceita tanto um CPF quanto um CNPJ válidos
+func IsValidCPFOrCNPJ(document string) bool {
+	digits := OnlyDigits(document)
+	switch len(digits) {
+	case 11:
+		return IsValidCPF(digits)
+	case 14:
+		return IsValidCNPJ(digits)
+	}
+	return false
+}
+
+// FormatCPF aplica a máscara 000.000.000-00
+func FormatCPF(cpf string) string {
+	d := OnlyDigits(cpf)
+	if len(d) != 11 {
+		return cpf
+	}
+	return fmt.Sprintf("%s.%s.%s-%s", d[0:3], d[3:6], d[6:9], d[9:11])
+}
+
+// FormatCNPJ aplica a máscara 00.000.000/0000-00
+func FormatCNPJ(cnpj string) string {
+	d := OnlyDigits(cnpj)
+	if len(d) != 14 {
+		return cnpj
+	}
+	return fmt.Sprintf("%s.%s.%s/%s-%s", d[0:2], d[2:5], d[5:8], d[8:12], d[12:14])
+}
+
+func allSameDigit(digits string) bool {
+	for i := 1; i < len(digits); i++ {
+		if digits[i] != digits[0] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package validation
+
+import "testing"
+
+func TestIsValidCPF(t *testing.T) {
+	tests := []struct {
+		cpf  string
+		want bool
+	}{
+		{"529.982.247-25", true},
+		{"52998224725", true},
+		{"111.444.777-35", true},
+		{"529.982.247-24", false},
+		{"529.982.247-15", false},
+		{"111.111.111-11", false},
+		{"000.000.000-00", false},
+		{"5299822472", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValidCPF(tt.cpf); got != tt.want {
+			t.Errorf("IsValidCPF(%q) = %v, esperado %v", tt.cpf, got, tt.want)
+		}
+	}
+}
+
+func TestIsValidCNPJ(t *testing.T) {
+	tests := []struct {
+		cnpj string
+		want bool
+	}{
+		// Banco do Brasil e Petrobras
+		{"00.000.000/0001-91", true},
+		{"33.000.167/0001-01", true},
+		{"11.222.333/0001-81", true},
+		{"11222333000181", true},
+		{"11.222.333/0001-80", false},
+		{"11.222.333/0001-71", false},
+		{"00.000.000/0000-00", false},
+		{"1122233300018", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValidCNPJ(tt.cnpj); got != tt.want {
+			t.Errorf("IsValidCNPJ(%q) = %v, esperado %v", tt.cnpj, got, tt.want)
+		}
+	}
+}
+
+func TestIsValidCPFOrCNPJ(t *testing.T) {
+	if !IsValidCPFOrCNPJ("52998224725") || !IsValidCPFOrCNPJ("00000000000191") {
+		t.Error("CPF e CNPJ válidos devem ser aceitos")
+	}
+	if IsValidCPFOrCNPJ("529982247") {
+		t.Error("documento com tamanho inválido deve ser rejeitado")
+	}
+}
+
+func TestFormatDocuments(t *testing.T) {
+	if got := FormatCPF("52998224725"); got != "529.982.247-25" {
+		t.Errorf("FormatCPF = %q", got)
+	}
+	if got := FormatCNPJ("33000167000101"); got != "33.000.167/0001-01" {
+		t.Errorf("FormatCNPJ = %q", got)
+	}
+}
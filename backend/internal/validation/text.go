@@ -0,0 +1,21 @@
+package validation
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeName prepara nomes para busca e comparação: remove acentos,
+// converte para minúsculas e colapsa espaços ("  José  da SILVA" → "jose da silva")
+func NormalizeName(name string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	result, _, err := transform.String(t, name)
+	if err != nil {
+		result = name
+	}
+	return strings.Join(strings.Fields(strings.ToLower(result)), " ")
+}
@@ -1,4 +1,5 @@
 import axios from 'axios';
+import { attachRefreshInterceptor } from '@/services/session';
 
 const api = axios.create({
   baseURL: import.meta.env.VITE_API_URL || 'http://localhost:3000',
@@ -10,27 +11,14 @@ const api = axios.create({
 
 // Interceptor para adicionar o token em todas as requisições
 api.interceptors.request.use((config) => {
-  const token = localStorage.getItem('token');
+  const token = localStorage.getItem('token') || sessionStorage.getItem('token');
   if (token) {
     config.headers.Authorization = `Bearer ${token}`;
   }
   return config;
 });
 
-// Interceptor para tratar erros
-api.interceptors.response.use(
-  (response) => response,
-  (error) => {
-    if (error.response?.status === 401) {
-      // Limpar dados de autenticação
-      localStorage.removeItem('jurisconnect_user');
-      localStorage.removeItem('jurisconnect_remember');
-      
-      // Redirecionar para login
-      window.location.href = '/login';
-    }
-    return Promise.reject(error);
-  }
-);
+// Renovar o token de acesso expirado; sem sessão válida, volta ao login
+attachRefreshInterceptor(api);
 
 export default api; 
\ No newline at end of file
@@ -1,4 +1,5 @@
 import axios from 'axios';
+import { attachRefreshInterceptor } from './session';
 
 export const api = axios.create({
   baseURL: import.meta.env.VITE_API_URL,
@@ -13,4 +14,6 @@ api.interceptors.request.use((config) => {
     config.headers.Authorization = `Bearer ${token}`;
   }
   return config;
-}); 
\ No newline at end of file
+});
+
+attachRefreshInterceptor(api);
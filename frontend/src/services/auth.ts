@@ -37,6 +37,7 @@ export interface RegisterData {
 }
 
 export interface AuthResponse {
+  token?: string;
   user: {
     id: string;
     name: string;
@@ -49,6 +50,8 @@ class AuthService {
   private static instance: AuthService;
   private static readonly USER_KEY = 'jurisconnect_user';
   private static readonly REMEMBER_KEY = 'jurisconnect_remember';
+  private static readonly TOKEN_KEY = 'token';
+  private static readonly REFRESH_TOKEN_KEY = 'refresh_token';
 
   private constructor() {}
 
@@ -62,13 +65,18 @@ class AuthService {
   public async login(credentials: LoginCredentials): Promise<AuthResponse> {
     try {
       const response = await api.post('/api/login', credentials);
-      const { user } = response.data;
+      const { user, token, refresh_token } = response.data;
       
+      // Salvar tokens (sessionStorage quando "lembrar-me" não estiver marcado)
+      const storage = credentials.remember ? localStorage : sessionStorage;
+      storage.setItem(AuthService.TOKEN_KEY, token);
+      storage.setItem(AuthService.REFRESH_TOKEN_KEY, refresh_token);
+
       // Salvar dados do usuário
       localStorage.setItem(AuthService.USER_KEY, JSON.stringify(user));
       localStorage.setItem(AuthService.REMEMBER_KEY, String(credentials.remember));
       
-      return { user };
+      return { user, token };
     } catch (error) {
       if (error.response?.data?.error) {
         throw new Error(error.response.data.error);
@@ -96,12 +104,21 @@ class AuthService {
 
   public async logout(): Promise<void> {
     try {
-      await api.post('/api/logout');
+      const refreshToken =
+        localStorage.getItem(AuthService.REFRESH_TOKEN_KEY) ||
+        sessionStorage.getItem(AuthService.REFRESH_TOKEN_KEY);
+      if (refreshToken) {
+        await api.post('/api/auth/logout', { refresh_token: refreshToken });
+      }
     } catch (error) {
       console.error('Erro ao fazer logout:', error);
     } finally {
       localStorage.removeItem(AuthService.USER_KEY);
       localStorage.removeItem(AuthService.REMEMBER_KEY);
+      localStorage.removeItem(AuthService.TOKEN_KEY);
+      sessionStorage.removeItem(AuthService.TOKEN_KEY);
+      localStorage.removeItem(AuthService.REFRESH_TOKEN_KEY);
+      sessionStorage.removeItem(AuthService.REFRESH_TOKEN_KEY);
     }
   }
 
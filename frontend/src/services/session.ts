@@ -0,0 +1,81 @@
+import axios, { AxiosError, AxiosInstance, InternalAxiosRequestConfig } from 'axios';
+
+const TOKEN_KEY = 'token';
+const REFRESH_TOKEN_KEY = 'refresh_token';
+
+// Rotas em que um 401 não indica token de acesso expirado
+const AUTH_ROUTES = ['/api/login', '/api/auth/refresh', '/api/auth/logout'];
+
+type RetriableRequest = InternalAxiosRequestConfig & { _retry?: boolean };
+
+// Renovação em andamento, compartilhada pelas requisições que falharem juntas
+let refreshing: Promise<string> | null = null;
+
+// Storage em que a sessão foi salva no login ("lembrar-me" usa o localStorage)
+function storedSession(): Storage | null {
+  if (localStorage.getItem(REFRESH_TOKEN_KEY)) return localStorage;
+  if (sessionStorage.getItem(REFRESH_TOKEN_KEY)) return sessionStorage;
+  return null;
+}
+
+export function clearSession() {
+  localStorage.removeItem('jurisconnect_user');
+  localStorage.removeItem('jurisconnect_remember');
+  localStorage.removeItem(TOKEN_KEY);
+  sessionStorage.removeItem(TOKEN_KEY);
+  localStorage.removeItem(REFRESH_TOKEN_KEY);
+  sessionStorage.removeItem(REFRESH_TOKEN_KEY);
+}
+
+async function refreshTokens(baseURL?: string): Promise<string> {
+  const storage = storedSession();
+  if (!storage) {
+    throw new Error('sessão sem refresh token');
+  }
+
+  // Chamada fora da instância para não passar pelos interceptors
+  const response = await axios.post(
+    '/api/auth/refresh',
+    { refresh_token: storage.getItem(REFRESH_TOKEN_KEY) },
+    { baseURL },
+  );
+  const { token, refresh_token } = response.data;
+  storage.setItem(TOKEN_KEY, token);
+  storage.setItem(REFRESH_TOKEN_KEY, refresh_token);
+  return token;
+}
+
+// Ao receber 401, renova o token de acesso com o refresh token e repete a
+// requisição uma vez; se a renovação falhar, encerra a sessão local
+export function attachRefreshInterceptor(instance: AxiosInstance) {
+  instance.interceptors.response.use(
+    (response) => response,
+    async (error: AxiosError) => {
+      const request = error.config as RetriableRequest | undefined;
+      if (
+        error.response?.status !== 401 ||
+        !request ||
+        request._retry ||
+        AUTH_ROUTES.some((route) => request.url?.endsWith(route))
+      ) {
+        return Promise.reject(error);
+      }
+      request._retry = true;
+
+      try {
+        if (!refreshing) {
+          refreshing = refreshTokens(instance.defaults.baseURL).finally(() => {
+            refreshing = null;
+          });
+        }
+        const token = await refreshing;
+        request.headers.Authorization = `Bearer ${token}`;
+        return instance(request);
+      } catch {
+        clearSession();
+        window.location.href = '/login';
+        return Promise.reject(error);
+      }
+    },
+  );
+}